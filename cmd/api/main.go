@@ -12,14 +12,13 @@ import (
 
 	"github.com/gabrielmatsan/checkin-gate/internal/config"
 	eventshttp "github.com/gabrielmatsan/checkin-gate/internal/events/infra/http"
-	"github.com/gabrielmatsan/checkin-gate/internal/events/infra/pdf"
 	infraqueue "github.com/gabrielmatsan/checkin-gate/internal/events/infra/queue"
-	"github.com/gabrielmatsan/checkin-gate/internal/events/infra/worker"
 	identityhttp "github.com/gabrielmatsan/checkin-gate/internal/identity/infra/http"
 	"github.com/gabrielmatsan/checkin-gate/internal/shared"
-	"github.com/gabrielmatsan/checkin-gate/internal/shared/mail"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/storage"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	goredis "github.com/redis/go-redis/v9"
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 	"go.uber.org/zap"
 
@@ -51,16 +50,22 @@ func main() {
 		logger.Fatal("failed to load config", zap.Error(err))
 	}
 
-	// Redis
-	redis, err := shared.NewRedis(cfg.RedisURL, logger)
-	if err != nil {
-		logger.Fatal("failed to connect to redis", zap.Error(err))
-	}
-	defer func() {
-		if err := redis.Close(); err != nil {
-			logger.Error("failed to close redis", zap.Error(err))
+	// Redis (fila de certificados no driver redis e replay de Idempotency-Key)
+	var redisClient *goredis.Client
+	if cfg.RedisURL != "" {
+		redis, err := shared.NewRedis(cfg.RedisURL, logger)
+		if err != nil {
+			logger.Fatal("failed to connect to redis", zap.Error(err))
 		}
-	}()
+		defer func() {
+			if err := redis.Close(); err != nil {
+				logger.Error("failed to close redis", zap.Error(err))
+			}
+		}()
+		redisClient = redis.Client
+	} else {
+		logger.Warn("REDIS_URL not set, idempotency keys are ignored")
+	}
 
 	// Database
 	db, err := shared.NewDatabase(cfg.DatabaseURL, logger)
@@ -79,6 +84,20 @@ func main() {
 	}
 	logger.Info("database connected")
 
+	// Blob storage
+	blobStorage, err := storage.NewBlobStorageFromConfig(context.Background(), cfg.Storage)
+	if err != nil {
+		logger.Fatal("failed to create blob storage", zap.Error(err))
+	}
+	logger.Info("blob storage configured", zap.String("driver", cfg.Storage.Driver))
+
+	// Certificate queue
+	certificateQueue, err := infraqueue.NewCertificateQueueFromConfig(redisClient, db.DB, cfg.CertificateQueue)
+	if err != nil {
+		logger.Fatal("failed to create certificate queue", zap.Error(err))
+	}
+	logger.Info("certificate queue configured", zap.String("driver", cfg.CertificateQueue.Driver))
+
 	// Router
 	router := chi.NewRouter()
 	router.Use(middleware.Recoverer)
@@ -135,25 +154,7 @@ func main() {
 	}
 
 	identityhttp.RegisterIdentityRoutes(router, db.DB, cfg)
-	eventshttp.RegisterEventsRoutes(router, db.DB, redis.Client, cfg, logger)
-
-	// Certificate worker
-	workerCtx, workerCancel := context.WithCancel(context.Background())
-	defer workerCancel()
-
-	certificateGenerator := pdf.NewMarotoGenerator()
-
-	// Email service (Resend)
-	emailService := mail.NewResendService(cfg.ResendKey, cfg.ResendFrom)
-	logger.Info("email service configured", zap.String("from", cfg.ResendFrom))
-
-	certificateQueue := infraqueue.NewRedisCertificateQueue(redis.Client)
-	certificateWorker := worker.NewCertificateWorker(certificateQueue, certificateGenerator, emailService, logger)
-	go func() {
-		if err := certificateWorker.Start(workerCtx); err != nil {
-			logger.Error("certificate worker failed", zap.Error(err))
-		}
-	}()
+	eventshttp.RegisterEventsRoutes(router, db.DB, redisClient, blobStorage, certificateQueue, cfg, logger)
 
 	// Server
 	srv := &http.Server{
@@ -188,8 +189,6 @@ func main() {
 
 	logger.Info("shutting down server")
 
-	workerCancel()
-
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
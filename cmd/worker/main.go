@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/config"
+	processwaitlist "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/process_waitlist"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/infra/pdf"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/infra/persistence"
+	infraqueue "github.com/gabrielmatsan/checkin-gate/internal/events/infra/queue"
+	eventsvc "github.com/gabrielmatsan/checkin-gate/internal/events/infra/service"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/infra/worker"
+	identitypersistence "github.com/gabrielmatsan/checkin-gate/internal/identity/infra/persistence"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/mail"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/storage"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	goredis "github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+type healthResponse struct {
+	Status      string `json:"status"`
+	InFlight    int64  `json:"in_flight"`
+	QueueLength int64  `json:"queue_length"`
+}
+
+func main() {
+	// Logger
+	logger, err := zap.NewProduction()
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err := logger.Sync(); err != nil {
+			fmt.Printf("failed to sync logger: %v\n", err)
+		}
+	}()
+
+	// Config
+	cfg, err := config.LoadWorker()
+	if err != nil {
+		logger.Fatal("failed to load worker config", zap.Error(err))
+	}
+
+	// Redis (apenas para a fila de certificados no driver redis)
+	var redis *shared.Redis
+	if cfg.CertificateQueue.Driver == infraqueue.DriverRedis {
+		redis, err = shared.NewRedis(cfg.RedisURL, logger)
+		if err != nil {
+			logger.Fatal("failed to connect to redis", zap.Error(err))
+		}
+		defer func() {
+			if err := redis.Close(); err != nil {
+				logger.Error("failed to close redis", zap.Error(err))
+			}
+		}()
+	}
+
+	// Database
+	db, err := shared.NewDatabase(cfg.DatabaseURL, logger)
+	if err != nil {
+		logger.Fatal("failed to connect to database", zap.Error(err))
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Error("failed to close database", zap.Error(err))
+		}
+	}()
+
+	// Email service (Resend)
+	emailService := mail.NewResendService(cfg.ResendKey, cfg.ResendFrom)
+	logger.Info("email service configured", zap.String("from", cfg.ResendFrom))
+
+	// Blob storage
+	blobStorage, err := storage.NewBlobStorageFromConfig(context.Background(), cfg.Storage)
+	if err != nil {
+		logger.Fatal("failed to create blob storage", zap.Error(err))
+	}
+	logger.Info("blob storage configured", zap.String("driver", cfg.Storage.Driver))
+
+	// Certificate worker
+	certificateGenerator := pdf.NewMarotoGenerator()
+	var redisClient *goredis.Client
+	if redis != nil {
+		redisClient = redis.Client
+	}
+	certificateQueue, err := infraqueue.NewCertificateQueueFromConfig(redisClient, db.DB, cfg.CertificateQueue)
+	if err != nil {
+		logger.Fatal("failed to create certificate queue", zap.Error(err))
+	}
+	certificateRepo := persistence.NewPostgresCertificateRepository(db.DB)
+	certificateJobRepo := persistence.NewPostgresCertificateJobRepository(db.DB)
+	templateLoader := pdf.NewTemplateLoader(persistence.NewPostgresCertificateTemplateRepository(db.DB), blobStorage)
+	eventRepo := persistence.NewPostgresEventRepository(db.DB)
+	activityRepo := persistence.NewPostgresActivityRepository(db.DB)
+	checkInRepo := persistence.NewPostgresCheckInRepository(db.DB)
+	certificateWorker := worker.NewCertificateWorker(certificateQueue, certificateGenerator, templateLoader, emailService, certificateRepo, certificateJobRepo, eventRepo, activityRepo, checkInRepo, blobStorage, logger, worker.CertificateWorkerConfig{
+		Concurrency:   cfg.Concurrency,
+		PollTimeout:   cfg.PollTimeout,
+		VerifyBaseURL: cfg.PublicBaseURL,
+		// renova a reserva algumas vezes dentro do tempo de visibilidade
+		LeaseExtendInterval: cfg.CertificateQueue.VisibilityTimeout / 3,
+	})
+
+	eventsTxProvider := persistence.NewPostgresTransactionProvider(db.DB).WithCertificateQueue(certificateQueue)
+
+	// Certificate outbox relay
+	outboxRelay := worker.NewCertificateOutboxRelay(eventsTxProvider, certificateQueue, logger, worker.CertificateOutboxRelayConfig{
+		Interval:  cfg.OutboxRelayInterval,
+		BatchSize: cfg.OutboxRelayBatchSize,
+	})
+
+	// Waitlist sweeper
+	processWaitlist := processwaitlist.NewUseCase(
+		eventsTxProvider,
+		eventsvc.NewUserAuthorizationAdapter(identitypersistence.NewPostgresUserRepository(db.DB)),
+		eventsvc.NewEmailAttendeeNotifier(emailService),
+		cfg.WaitlistConfirmationWindow,
+	)
+	waitlistSweeper := worker.NewWaitlistSweeper(persistence.NewPostgresRegistrationRepository(db.DB), processWaitlist, logger, cfg.WaitlistSweepInterval)
+
+	// Health check
+	var draining atomic.Bool
+
+	router := chi.NewRouter()
+	router.Use(middleware.Recoverer)
+
+	router.Get("/health", func(w http.ResponseWriter, r *http.Request) {
+		if draining.Load() {
+			lib.RespondJSON(w, http.StatusServiceUnavailable, healthResponse{
+				Status:      "draining",
+				InFlight:    certificateWorker.InFlight(),
+				QueueLength: 0,
+			})
+			return
+		}
+
+		if redis != nil {
+			if err := redis.HealthCheck(); err != nil {
+				lib.RespondError(w, http.StatusServiceUnavailable, "redis unavailable")
+				return
+			}
+		}
+
+		if err := db.HealthCheck(); err != nil {
+			lib.RespondError(w, http.StatusServiceUnavailable, "database unavailable")
+			return
+		}
+
+		queueLength, err := certificateQueue.Len(r.Context())
+		if err != nil {
+			lib.RespondError(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+
+		lib.RespondJSON(w, http.StatusOK, healthResponse{
+			Status:      "ok",
+			InFlight:    certificateWorker.InFlight(),
+			QueueLength: queueLength,
+		})
+	})
+
+	srv := &http.Server{
+		Addr:         fmt.Sprintf(":%d", cfg.HealthPort),
+		Handler:      router,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Fatal("health server failed", zap.Error(err))
+		}
+	}()
+
+	workerCtx, workerCancel := context.WithCancel(context.Background())
+	defer workerCancel()
+
+	sweeperDone := make(chan struct{})
+	go func() {
+		defer close(sweeperDone)
+		waitlistSweeper.Start(workerCtx)
+	}()
+
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		outboxRelay.Start(workerCtx)
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := certificateWorker.Start(workerCtx); err != nil {
+			logger.Error("certificate worker failed", zap.Error(err))
+		}
+	}()
+
+	logger.Info("certificate worker running",
+		zap.Int("health_port", cfg.HealthPort),
+		zap.String("queue_driver", cfg.CertificateQueue.Driver),
+		zap.String("queue_key", cfg.CertificateQueue.Key),
+	)
+
+	// Graceful drain
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case <-quit:
+	case <-done:
+		logger.Error("certificate worker exited unexpectedly")
+	}
+
+	logger.Info("draining certificate worker", zap.Duration("timeout", cfg.ShutdownTimeout))
+
+	draining.Store(true)
+	workerCancel()
+
+	<-sweeperDone
+	<-relayDone
+
+	select {
+	case <-done:
+		logger.Info("certificate worker drained")
+	case <-time.After(cfg.ShutdownTimeout):
+		// os jobs não confirmados voltam para a fila quando a reserva expirar
+		logger.Warn("drain timeout reached, exiting with jobs in flight",
+			zap.Int64("in_flight", certificateWorker.InFlight()),
+		)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		logger.Error("health server forced to shutdown", zap.Error(err))
+	}
+
+	logger.Info("worker stopped")
+}
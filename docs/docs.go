@@ -15,16 +15,13 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/activities/{activity_id}/checkin": {
-            "post": {
-                "description": "Performs a check-in to an activity. User must be authenticated.",
-                "produces": [
-                    "application/json"
-                ],
+        "/activities/{activity_id}": {
+            "delete": {
+                "description": "Deletes an activity. Activities with check-ins are only deleted with force=true, which also deletes the check-ins. Activities of completed events and activities with issued certificates cannot be deleted. Admin only.",
                 "tags": [
-                    "CheckIn"
+                    "Activities"
                 ],
-                "summary": "Check-in to activity",
+                "summary": "Delete activity",
                 "parameters": [
                     {
                         "type": "string",
@@ -32,17 +29,26 @@ const docTemplate = `{
                         "name": "activity_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also delete the check-ins",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid force parameter",
                         "schema": {
-                            "$ref": "#/definitions/handler.CheckInActivityResponse"
+                            "$ref": "#/definitions/lib.ErrorResponse"
                         }
                     },
-                    "400": {
-                        "description": "Already checked in or outside activity time",
+                    "403": {
+                        "description": "User is not an admin",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
@@ -53,6 +59,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Event is completed, activity has check-ins or issued certificates",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -60,104 +72,70 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/auth/google/callback": {
-            "get": {
-                "description": "Exchanges a Google authorization code for access and refresh tokens",
+            },
+            "patch": {
+                "description": "Updates the fields sent in the body. The start date must stay before the end date, new dates must be within the event, the activity cannot overlap another one in the same track and the name must stay unique in the event. A capacity of 0 removes the registration limit. Activities of completed events cannot be edited. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Activities"
                 ],
-                "summary": "Authenticate with Google",
+                "summary": "Update activity",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Google authorization code",
-                        "name": "code",
-                        "in": "query",
+                        "description": "Activity ID",
+                        "name": "activity_id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "State parameter for CSRF protection",
-                        "name": "state",
-                        "in": "query",
-                        "required": true
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateActivityRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GoogleCallbackResponse"
+                            "$ref": "#/definitions/handler.ActivityResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid request body, inconsistent dates, overlapping track or duplicate name",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "User is not an admin",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/auth/google/url": {
-            "get": {
-                "description": "Generates a new Google OAuth URL for authentication",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Auth"
-                ],
-                "summary": "Get Google OAuth URL",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.GetGoogleAuthURLResponse"
-                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Activity not found",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/auth/refresh": {
-            "post": {
-                "description": "Uses a valid refresh token to obtain a new access token and rotated refresh token",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Auth"
-                ],
-                "summary": "Refresh tokens",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "409": {
+                        "description": "Event is completed",
                         "schema": {
-                            "$ref": "#/definitions/handler.RefreshTokenResponse"
+                            "$ref": "#/definitions/lib.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
@@ -165,9 +143,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/events": {
+        "/activities/{activity_id}/checkin": {
             "post": {
-                "description": "Creates a new event. Only admins can create events.",
+                "description": "Performs a check-in to an activity. User must be authenticated. When the activity requires in-person check-in, the rotating token shown on the activity QR code must be sent in the body or in the token query parameter. When the activity (or its event) has a venue, the body must include the participant latitude/longitude and the check-in is rejected outside the venue radius. Events that require registration only accept registered participants, and activities with limited capacity only accept participants registered in the activity.",
                 "consumes": [
                     "application/json"
                 ],
@@ -175,41 +153,65 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Events"
+                    "CheckIn"
                 ],
-                "summary": "Create event",
+                "summary": "Check-in to activity",
                 "parameters": [
                     {
-                        "description": "Event details",
-                        "name": "body",
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Rotating check-in token",
+                        "name": "token",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Check-in data",
+                        "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateEventRequest"
+                            "$ref": "#/definitions/handler.CheckInActivityRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the same key is sent again",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateEventResponse"
+                            "$ref": "#/definitions/handler.CheckInActivityResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body or validation error",
+                        "description": "Already checked in",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Missing or invalid check-in token, missing location, outside the venue or not registered",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "User not authorized to create event",
+                    "404": {
+                        "description": "Activity not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Outside the activity check-in window or event not open for check-in",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
@@ -223,9 +225,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/activities": {
-            "post": {
-                "description": "Creates one or more activities for an event. User must be authenticated.",
+        "/activities/{activity_id}/checkin-settings": {
+            "patch": {
+                "description": "Updates how participants check in to an activity. token_required: check-in requires the rotating token shown on the activity QR code. token_period_seconds: how often the token rotates (10-300). window_enabled: check-in is only accepted between opens_before_minutes before the activity start and closes_after_minutes after its end (0-1440). Omitted fields are kept. Admin only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -233,32 +235,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Activities"
+                    "CheckIn"
                 ],
-                "summary": "Create activities",
+                "summary": "Update check-in settings",
                 "parameters": [
                     {
-                        "description": "Activities to create",
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Check-in settings",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateActivitiesRequest"
+                            "$ref": "#/definitions/handler.UpdateCheckInSettingsRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/handler.CreateActivityResponse"
-                            }
+                            "$ref": "#/definitions/handler.CheckInSettingsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body or validation error",
+                        "description": "Invalid request body or settings",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Activity not found",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
@@ -272,24 +290,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{event_id}/activities": {
+        "/activities/{activity_id}/checkin-token": {
             "get": {
-                "description": "Gets an event with its activities.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the current rotating check-in token of an activity and when it expires. The token changes every period_seconds; poll this endpoint (or the QR endpoint) to keep the projector updated. Admin only.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Events"
+                    "CheckIn"
                 ],
-                "summary": "Get event with activities",
+                "summary": "Get check-in token",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID",
-                        "name": "event_id",
+                        "description": "Activity ID",
+                        "name": "activity_id",
                         "in": "path",
                         "required": true
                     }
@@ -298,17 +313,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.EventWithActivitiesResponse"
+                            "$ref": "#/definitions/handler.CheckInTokenResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body or validation error",
+                        "description": "Check-in token not enabled for activity",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Event not found",
+                        "description": "Activity not found",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
@@ -322,21 +343,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{event_id}/details": {
+        "/activities/{activity_id}/checkin-token/qr": {
             "get": {
-                "description": "Gets an event with all activities and their check-ins. Admin only.",
+                "description": "Renders the current rotating check-in token of an activity as a PNG QR code, to be shown on the projector. The QR code encodes the check-in app URL (CHECKIN_APP_URL) with activity_id and token in the query, or just the token when no app URL is configured. Admin only.",
                 "produces": [
-                    "application/json"
+                    "image/png"
                 ],
                 "tags": [
-                    "Events"
+                    "CheckIn"
                 ],
-                "summary": "Get event details",
+                "summary": "Get check-in QR code",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID",
-                        "name": "event_id",
+                        "description": "Activity ID",
+                        "name": "activity_id",
                         "in": "path",
                         "required": true
                     }
@@ -345,17 +366,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.EventDetailsResponse"
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Check-in token not enabled for activity",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
                         }
                     },
                     "403": {
-                        "description": "User not authorized",
+                        "description": "User is not an admin",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Event not found",
+                        "description": "Activity not found",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
@@ -369,49 +396,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/events/{event_id}/finish": {
+        "/activities/{activity_id}/checkins": {
             "post": {
-                "description": "Finishes an event and enqueues certificate generation jobs for all check-ins. Admin only.",
+                "description": "Registers a check-in for another user, found by user ID, email or CPF (exactly one). Only admins can register manual check-ins. The QR token and venue checks are skipped, the event domain rules still apply and the time window can be overridden. The admin and the reason are recorded on the check-in.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Events"
+                    "CheckIn"
                 ],
-                "summary": "Finish event",
+                "summary": "Register manual check-in",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID",
-                        "name": "event_id",
+                        "description": "Activity ID",
+                        "name": "activity_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Attendee and reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.RegisterManualCheckInRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the same key is sent again",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Jobs enqueued",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handler.RegisterManualCheckInResponse"
                         }
                     },
                     "400": {
-                        "description": "Activities not ended or no check-ins",
+                        "description": "Invalid request or already checked in",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "User is not an admin",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Event not found",
+                    "403": {
+                        "description": "User is not an admin or attendee domain not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Activity or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Outside the activity check-in window or event not open for check-in",
                         "schema": {
                             "$ref": "#/definitions/lib.ErrorResponse"
                         }
@@ -424,56 +478,3135 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "handler.ActivityResponse": {
-            "type": "object",
-            "properties": {
-                "created_at": {
+        },
+        "/activities/{activity_id}/checkins/{checkin_id}/revoke": {
+            "post": {
+                "description": "Revokes a check-in made by mistake or by fraud. The check-in is kept for audit with the admin and the reason, but no longer counts for certificates nor appears in the event details. Certificates already issued for the activity are revoked in the same transaction, and so is the participant's consolidated event certificate when the remaining check-ins no longer meet the attendance rule. Only admins can revoke check-ins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CheckIn"
+                ],
+                "summary": "Revoke check-in",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Check-in ID",
+                        "name": "checkin_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Revocation reason",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.RevokeCheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RevokeCheckInResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Check-in not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Check-in already revoked",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/activities/{activity_id}/checkout": {
+            "post": {
+                "description": "Registers the check-out of the authenticated user from an activity and stores the attended time (limited to the activity schedule). Requires a previous check-in.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CheckIn"
+                ],
+                "summary": "Check-out from activity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CheckOutActivityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Already checked out",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Activity or check-in not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/activities/{activity_id}/venue": {
+            "put": {
+                "description": "Sets the venue of an activity, overriding the event venue. Check-ins must be sent from within radius_meters of the venue coordinates. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Activities"
+                ],
+                "summary": "Set activity venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activity_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Venue",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.VenueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ActivityVenueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or venue",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Activity not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes the venue of an activity; check-ins then use the event venue, if any. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Activities"
+                ],
+                "summary": "Remove activity venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Activity ID",
+                        "name": "activity_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ActivityVenueResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Activity not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/google/callback": {
+            "get": {
+                "description": "Exchanges a Google authorization code for access and refresh tokens",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Authenticate with Google",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Google authorization code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "State parameter for CSRF protection",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GoogleCallbackResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/google/url": {
+            "get": {
+                "description": "Generates a new Google OAuth URL for authentication",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Get Google OAuth URL",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetGoogleAuthURLResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "Uses a valid refresh token to obtain a new access token and rotated refresh token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Refresh tokens",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RefreshTokenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certificates/dead-letters": {
+            "get": {
+                "description": "Lists certificate jobs that exhausted all retry attempts. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "List dead letter certificate jobs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Max number of jobs (default 50, max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.DeadLetterJobResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid limit",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certificates/dead-letters/{job_id}/replay": {
+            "post": {
+                "description": "Moves a job from the dead letter list back to the certificate queue with its attempts reset. The job status goes back to queued, so it cannot be resent while the replayed copy is being processed. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Replay dead letter certificate job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handler.DeadLetterJobResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Job not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certificates/verify/{code}": {
+            "get": {
+                "description": "Public endpoint that checks a certificate verification code and returns who earned it, for which event and activity, the workload and whether it is still valid.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Verify certificate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Verification code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.VerifyCertificateResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Certificate not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/certificates/{certificate_id}/pdf": {
+            "get": {
+                "description": "Downloads the stored PDF of a certificate. Only the certificate owner or an admin can download it; revoked certificates are no longer available.",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Download certificate PDF",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Certificate ID",
+                        "name": "certificate_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Certificate or file not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events": {
+            "get": {
+                "description": "Lists events from the most recent, with cursor-based pagination. Admins see every event and can filter by status; other users only see published events open to their email domain. Use next_cursor as the cursor of the next page.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "List events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated statuses (draft, published, cancelled, completed), admin only",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Events ending at or after this date (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Events starting at or before this date (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by name",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ListEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filters or cursor",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new event. Only admins can create events.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Create event",
+                "parameters": [
+                    {
+                        "description": "Event details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User not authorized to create event",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/activities": {
+            "post": {
+                "description": "Creates one or more activities for an event. Each activity must start before it ends and fall within the event dates, and activities in the same track cannot overlap. When any activity is invalid, nothing is created and the response lists the problems of each one. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Activities"
+                ],
+                "summary": "Create activities",
+                "parameters": [
+                    {
+                        "description": "Activities to create",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateActivitiesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.CreateActivityResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid activity dates or overlapping tracks",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateActivitiesErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}": {
+            "delete": {
+                "description": "Deletes an event and its activities. Events with check-ins are only deleted with force=true, which also deletes the check-ins. Completed events and events with issued certificates cannot be deleted. Admin only.",
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Delete event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also delete the check-ins",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid force parameter",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event is completed, has check-ins or issued certificates",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Updates the fields sent in the body. The start date must stay before the end date and, when the dates change, all activities must stay within the event. With registration_required, only registered participants can check in. Completed events cannot be edited. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Update event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.EventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or inconsistent dates",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event is completed",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/activities": {
+            "get": {
+                "description": "Gets an event with its activities.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Get event with activities",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.EventWithActivitiesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/cancel": {
+            "post": {
+                "description": "Cancels a draft or published event, blocking new check-ins. Attendees with check-ins are notified by email, with the optional reason; the emails are sent in the background after the response, and attendees is how many will be notified. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Cancel event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation reason",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CancelEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CancelEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event already cancelled or completed",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/certificate-settings": {
+            "patch": {
+                "description": "Updates the certificate settings of an event. certificate_mode: per_activity (one certificate per attended activity), consolidated (one certificate per participant summing the workload of all attended activities) or both. attendance_rule: none, min_activities, min_percentage or min_hours, with attendance_threshold as the number of activities, percentage (0-100) or hours a participant must reach to receive certificates. workload_source: scheduled (activity start to end) or attended (time between check-in and check-out; check-ins without a check-out count as zero). Omitted fields are kept. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Update certificate settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Certificate settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateCertificateSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CertificateSettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or attendance rule",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/certificate-template": {
+            "get": {
+                "description": "Gets the certificate template of an event. Returns the default template (is_default=true) when the event has none. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Get certificate template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CertificateTemplateResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Creates or replaces the certificate template of an event: title, body text with placeholders ({{participant_name}}, {{event_name}}, {{activity_name}}, {{event_date}}, {{workload}}, {{issue_date}}), up to 3 signatories, logo and colors. Images are base64 PNG/JPEG up to 1MB; omit an image to keep the current one or send an empty string to remove it. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Save certificate template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Certificate template",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpsertCertificateTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CertificateTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body, placeholder or image",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the certificate template of an event and its images. The event goes back to the default template. Admin only.",
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Delete certificate template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Template deleted"
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Certificate template not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/certificate-template/preview": {
+            "get": {
+                "description": "Renders a sample certificate PDF using the current template of the event (or the default one) and fictitious participant data. Admin only.",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Preview certificate template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/certificates/jobs": {
+            "get": {
+                "description": "Lists the certificate jobs created when the event was finished, with the counts by status and the status of each participant's job (queued, generating, sending, sent, retrying after a failed attempt, or failed once the attempts are exhausted and the job is in the dead letter list; both keep the last error, or revoked when the certificate or check-in was revoked and the job was discarded). Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "List certificate jobs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "queued",
+                            "generating",
+                            "sending",
+                            "sent",
+                            "retrying",
+                            "failed",
+                            "revoked"
+                        ],
+                        "type": "string",
+                        "description": "Filter jobs by status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ListCertificateJobsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/certificates/jobs/resend-failed": {
+            "post": {
+                "description": "Sends every failed (dead-lettered) certificate job of the event back to the queue, removing them from the dead letter list, and returns how many were requeued. Jobs waiting for a retry are left to the queue and jobs whose certificate was revoked stay in the dead letter list. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Resend failed certificate jobs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ResendFailedCertificateJobsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/certificates/jobs/{job_id}/resend": {
+            "post": {
+                "description": "Sends a sent or failed (dead-lettered) certificate job back to the queue and removes it from the dead letter list. The certificate already issued is reused and the email is sent again. Jobs still queued, being processed or waiting for a retry cannot be resent, nor jobs whose certificate or check-in was revoked. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Resend certificate job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CertificateJobResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Job not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Job is still in progress or its certificate was revoked",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/checkin-rejections": {
+            "get": {
+                "description": "Lists the check-in attempts rejected by the venue geofence in the activities of an event, newest first. reason: location_missing or outside_venue. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CheckIn"
+                ],
+                "summary": "List check-in rejections",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.CheckInRejectionResponse"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/details": {
+            "get": {
+                "description": "Gets an event with all activities and their check-ins. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Get event details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.EventDetailsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/finish": {
+            "post": {
+                "description": "Finishes an event and schedules certificate generation jobs for all check-ins, according to the event certificate mode (one per activity, one consolidated per participant, or both). Participants below the event attendance rule do not receive certificates and are listed in not_qualified. Only published events can be finished. The operation is idempotent: finishing an already completed event again only schedules the certificates that do not exist yet (one per participant and activity, or per participant in the event for the consolidated one), e.g. after late manual check-ins. enqueued_jobs counts the new certificates and existing_certificates the ones from previous runs. The jobs are stored together with the event status change and published to the certificate queue by the worker shortly after; their progress is listed in GET /events/{event_id}/certificates/jobs. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Finish event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Jobs enqueued",
+                        "schema": {
+                            "$ref": "#/definitions/handler.FinishEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Activities not ended or no check-ins",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event is not published or completed",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/publish": {
+            "post": {
+                "description": "Publishes a draft event, opening it for check-ins. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Publish event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.EventStatusResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event is not a draft",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/registrations": {
+            "post": {
+                "description": "Registers the authenticated user for a published event and, optionally, for activities of the event. The event registration is created with the first activity registration. When an activity with limited capacity is full, the registration joins its waitlist (status waitlisted); when a spot opens, the next in line gets an email and must confirm it before the confirmation deadline, otherwise the spot passes to the next person. The user email domain must be allowed by the event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registrations"
+                ],
+                "summary": "Register for event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Activities to register for",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RegisterForEventRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Replays the stored response when the same key is sent again",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.RegistrationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User domain not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event or activity not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already registered",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Event not open for registration",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/registrations/{registration_id}": {
+            "delete": {
+                "description": "Cancels a registration of the authenticated user. Cancelling the event registration also cancels the registrations in its activities. Freed seats are offered to the next people on the waitlist. Admins can cancel any registration.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registrations"
+                ],
+                "summary": "Cancel registration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Registration ID",
+                        "name": "registration_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RegistrationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Registration not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Registration already cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/registrations/{registration_id}/confirm": {
+            "post": {
+                "description": "Confirms the activity spot offered to the authenticated user after leaving the waitlist (status pending_confirmation). The spot must be confirmed before the confirmation deadline, otherwise it passes to the next person in line.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registrations"
+                ],
+                "summary": "Confirm registration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Registration ID",
+                        "name": "registration_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RegistrationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Registration not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "No spot to confirm or confirmation deadline expired",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/reopen": {
+            "post": {
+                "description": "Moves a cancelled or completed event back to published, accepting check-ins again. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Reopen event",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.EventStatusResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Event is not cancelled or completed",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/events/{event_id}/venue": {
+            "put": {
+                "description": "Sets the venue of an event. Check-ins to activities without their own venue must be sent from within radius_meters of the venue coordinates. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Set event venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Venue",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.VenueRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.EventVenueResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body or venue",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Removes the venue of an event, disabling the geofence for activities without their own venue. Admin only.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Remove event venue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.EventVenueResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not an admin",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Event not found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/certificates": {
+            "get": {
+                "description": "Lists all certificates issued to the authenticated user, newest first. Certificates with pdf_available can be downloaded from /certificates/{certificate_id}/pdf.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "List my certificates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.UserCertificateResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/checkins": {
+            "get": {
+                "description": "Lists the active check-ins of the authenticated user, newest first, with the activity, the event and the certificate status (not_available, pending, issued or revoked). Use next_cursor as the cursor of the next page.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CheckIn"
+                ],
+                "summary": "List my check-ins",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only check-ins of this event",
+                        "name": "event_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ListUserCheckInsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid limit or cursor",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/cpf": {
+            "put": {
+                "description": "Sets the CPF of the authenticated user, used by admins to find attendees for manual check-ins",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update my CPF",
+                "parameters": [
+                    {
+                        "description": "CPF",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateUserCPFRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateUserCPFResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/lib.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handler.ActivityResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "track": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ActivityValidationErrorResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ActivityVenueResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "venue": {
+                    "$ref": "#/definitions/handler.VenueResponse"
+                }
+            }
+        },
+        "handler.ActivityWithCheckInsResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "activity_name": {
+                    "type": "string"
+                },
+                "check_ins": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.CheckInResponse"
+                    }
+                }
+            }
+        },
+        "handler.CancelEventRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "handler.CancelEventResponse": {
+            "type": "object",
+            "properties": {
+                "attendees": {
+                    "type": "integer"
+                },
+                "event": {
+                    "$ref": "#/definitions/handler.EventStatusResponse"
+                }
+            }
+        },
+        "handler.CertificateJobCountsResponse": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "generating": {
+                    "type": "integer"
+                },
+                "queued": {
+                    "type": "integer"
+                },
+                "retrying": {
+                    "type": "integer"
+                },
+                "revoked": {
+                    "type": "integer"
+                },
+                "sending": {
+                    "type": "integer"
+                },
+                "sent": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.CertificateJobResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "activity_name": {
+                    "type": "string"
+                },
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "job_id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "queued",
+                        "generating",
+                        "sending",
+                        "sent",
+                        "retrying",
+                        "failed",
+                        "revoked"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_email": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CertificateSettingsResponse": {
+            "type": "object",
+            "properties": {
+                "attendance_rule": {
+                    "type": "string"
+                },
+                "attendance_threshold": {
+                    "type": "number"
+                },
+                "certificate_mode": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "workload_source": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CertificateSignatoryRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "title"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "signature_image": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.CertificateSignatoryResponse": {
+            "type": "object",
+            "properties": {
+                "has_signature_image": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CertificateTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "accent_color": {
+                    "type": "string"
+                },
+                "body_text": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "has_logo": {
+                    "type": "boolean"
+                },
+                "is_default": {
+                    "type": "boolean"
+                },
+                "placeholders": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "primary_color": {
+                    "type": "string"
+                },
+                "secondary_color": {
+                    "type": "string"
+                },
+                "signatories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.CertificateSignatoryResponse"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CheckInActivityRequest": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CheckInActivityResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "checked_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CheckInRejectionResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "distance_meters": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CheckInResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "attended_minutes": {
+                    "type": "integer"
+                },
+                "checked_at": {
+                    "type": "string"
+                },
+                "checked_out_at": {
+                    "description": "preenchidos após o check-out",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "registered_by": {
+                    "description": "preenchidos no check-in manual feito por um admin",
+                    "type": "string"
+                },
+                "registration_reason": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CheckInSettingsResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "closes_after_minutes": {
+                    "type": "integer"
+                },
+                "opens_before_minutes": {
+                    "type": "integer"
+                },
+                "token_period_seconds": {
+                    "type": "integer"
+                },
+                "token_required": {
+                    "type": "boolean"
+                },
+                "window_enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.CheckInTokenResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "checkin_url": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "period_seconds": {
+                    "type": "integer"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CheckOutActivityResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "attended_minutes": {
+                    "type": "integer"
+                },
+                "checked_at": {
+                    "type": "string"
+                },
+                "checked_out_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CreateActivitiesErrorResponse": {
+            "type": "object",
+            "properties": {
+                "activities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ActivityValidationErrorResponse"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CreateActivitiesRequest": {
+            "type": "object",
+            "required": [
+                "activities",
+                "event_id"
+            ],
+            "properties": {
+                "activities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.CreateActivityItem"
+                    }
+                },
+                "event_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CreateActivityItem": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "name",
+                "start_date"
+            ],
+            "properties": {
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "track": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "venue": {
+                    "$ref": "#/definitions/handler.VenueRequest"
+                }
+            }
+        },
+        "handler.CreateActivityResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "track": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "venue": {
+                    "$ref": "#/definitions/handler.VenueResponse"
+                }
+            }
+        },
+        "handler.CreateEventRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "name",
+                "start_date"
+            ],
+            "properties": {
+                "allowed_domains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "venue": {
+                    "$ref": "#/definitions/handler.VenueRequest"
+                }
+            }
+        },
+        "handler.CreateEventResponse": {
+            "type": "object",
+            "properties": {
+                "allowed_domains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "venue": {
+                    "$ref": "#/definitions/handler.VenueResponse"
+                }
+            }
+        },
+        "handler.DeadLetterJobResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "activity_name": {
+                    "type": "string"
+                },
+                "attempts": {
+                    "type": "integer"
+                },
+                "enqueued_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_name": {
+                    "type": "string"
+                },
+                "job_id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "user_email": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.EventDetailsResponse": {
+            "type": "object",
+            "properties": {
+                "activities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ActivityWithCheckInsResponse"
+                    }
+                },
+                "allowed_domains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.EventResponse": {
+            "type": "object",
+            "properties": {
+                "allowed_domains": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "registration_required": {
+                    "type": "boolean"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.EventStatusResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.EventVenueResponse": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string"
+                },
+                "venue": {
+                    "$ref": "#/definitions/handler.VenueResponse"
+                }
+            }
+        },
+        "handler.EventWithActivitiesResponse": {
+            "type": "object",
+            "properties": {
+                "activities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ActivityResponse"
+                    }
+                },
+                "event": {
+                    "$ref": "#/definitions/handler.EventResponse"
+                }
+            }
+        },
+        "handler.FinishEventResponse": {
+            "type": "object",
+            "properties": {
+                "enqueued_jobs": {
+                    "description": "certificados novos agendados nesta chamada",
+                    "type": "integer"
+                },
+                "existing_certificates": {
+                    "description": "certificados já agendados por uma finalização anterior",
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "not_qualified": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.NotQualifiedUserResponse"
+                    }
+                },
+                "qualified_users": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.GetGoogleAuthURLResponse": {
+            "type": "object",
+            "properties": {
+                "state": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.GoogleCallbackResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/handler.GoogleCallbackUserResponse"
+                }
+            }
+        },
+        "handler.GoogleCallbackUserResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ListCertificateJobsResponse": {
+            "type": "object",
+            "properties": {
+                "counts": {
+                    "$ref": "#/definitions/handler.CertificateJobCountsResponse"
+                },
+                "jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.CertificateJobResponse"
+                    }
+                }
+            }
+        },
+        "handler.ListEventsResponse": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.EventResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ListUserCheckInsResponse": {
+            "type": "object",
+            "properties": {
+                "check_ins": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.UserCheckInResponse"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.NotQualifiedUserResponse": {
+            "type": "object",
+            "properties": {
+                "attended_activities": {
+                    "type": "integer"
+                },
+                "attended_minutes": {
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.RefreshTokenResponse": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "refresh_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.RegisterForEventRequest": {
+            "type": "object",
+            "required": [
+                "activity_ids"
+            ],
+            "properties": {
+                "activity_ids": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.RegisterManualCheckInRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "cpf": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "override_time_window": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.RegisterManualCheckInResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "checked_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "registered_by": {
+                    "type": "string"
+                },
+                "registration_reason": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.RegistrationResponse": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
                     "type": "string"
                 },
-                "description": {
+                "cancelled_at": {
                     "type": "string"
                 },
-                "end_date": {
+                "confirmation_deadline": {
                     "type": "string"
                 },
-                "event_id": {
+                "created_at": {
                     "type": "string"
                 },
-                "id": {
+                "event_id": {
                     "type": "string"
                 },
-                "name": {
+                "id": {
                     "type": "string"
                 },
-                "start_date": {
+                "status": {
                     "type": "string"
                 },
-                "updated_at": {
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "handler.ActivityWithCheckInsResponse": {
+        "handler.ResendFailedCertificateJobsResponse": {
             "type": "object",
             "properties": {
-                "activity_id": {
-                    "type": "string"
-                },
-                "activity_name": {
-                    "type": "string"
-                },
-                "check_ins": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.CheckInResponse"
-                    }
+                "requeued_jobs": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.CheckInActivityResponse": {
+        "handler.RevokeCheckInRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "handler.RevokeCheckInResponse": {
             "type": "object",
             "properties": {
                 "activity_id": {
@@ -485,104 +3618,115 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "user_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "handler.CheckInResponse": {
-            "type": "object",
-            "properties": {
-                "activity_id": {
+                "revocation_reason": {
                     "type": "string"
                 },
-                "checked_at": {
+                "revoked_at": {
                     "type": "string"
                 },
-                "id": {
+                "revoked_by": {
                     "type": "string"
                 },
+                "revoked_certificates": {
+                    "description": "certificados revogados junto com o check-in",
+                    "type": "integer"
+                },
                 "user_id": {
                     "type": "string"
                 }
             }
         },
-        "handler.CreateActivitiesRequest": {
+        "handler.UpdateActivityRequest": {
             "type": "object",
-            "required": [
-                "activities",
-                "event_id"
-            ],
             "properties": {
-                "activities": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.CreateActivityItem"
-                    }
+                "capacity": {
+                    "description": "número máximo de inscritos; 0 remove o limite",
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "event_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "handler.CreateActivityItem": {
-            "type": "object",
-            "required": [
-                "end_date",
-                "name",
-                "start_date"
-            ],
-            "properties": {
                 "description": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 500
                 },
                 "end_date": {
                     "type": "string"
                 },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
                 },
                 "start_date": {
                     "type": "string"
+                },
+                "track": {
+                    "description": "string vazia remove a atividade da trilha",
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "handler.CreateActivityResponse": {
+        "handler.UpdateCertificateSettingsRequest": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "string"
+                "attendance_rule": {
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "min_activities",
+                        "min_percentage",
+                        "min_hours"
+                    ]
                 },
-                "description": {
-                    "type": "string"
+                "attendance_threshold": {
+                    "type": "number",
+                    "minimum": 0
                 },
-                "end_date": {
-                    "type": "string"
+                "certificate_mode": {
+                    "type": "string",
+                    "enum": [
+                        "per_activity",
+                        "consolidated",
+                        "both"
+                    ]
                 },
-                "event_id": {
-                    "type": "string"
+                "workload_source": {
+                    "type": "string",
+                    "enum": [
+                        "scheduled",
+                        "attended"
+                    ]
+                }
+            }
+        },
+        "handler.UpdateCheckInSettingsRequest": {
+            "type": "object",
+            "properties": {
+                "closes_after_minutes": {
+                    "type": "integer",
+                    "maximum": 1440,
+                    "minimum": 0
                 },
-                "id": {
-                    "type": "string"
+                "opens_before_minutes": {
+                    "type": "integer",
+                    "maximum": 1440,
+                    "minimum": 0
                 },
-                "name": {
-                    "type": "string"
+                "token_period_seconds": {
+                    "type": "integer",
+                    "maximum": 300,
+                    "minimum": 10
                 },
-                "start_date": {
-                    "type": "string"
+                "token_required": {
+                    "type": "boolean"
                 },
-                "updated_at": {
-                    "type": "string"
+                "window_enabled": {
+                    "type": "boolean"
                 }
             }
         },
-        "handler.CreateEventRequest": {
+        "handler.UpdateEventRequest": {
             "type": "object",
-            "required": [
-                "end_date",
-                "name",
-                "start_date"
-            ],
             "properties": {
                 "allowed_domains": {
                     "type": "array",
@@ -602,177 +3746,237 @@ const docTemplate = `{
                     "maxLength": 255,
                     "minLength": 3
                 },
+                "registration_required": {
+                    "description": "quando true, só participantes inscritos fazem check-in",
+                    "type": "boolean"
+                },
                 "start_date": {
                     "type": "string"
                 }
             }
         },
-        "handler.CreateEventResponse": {
+        "handler.UpdateUserCPFRequest": {
             "type": "object",
+            "required": [
+                "cpf"
+            ],
             "properties": {
-                "allowed_domains": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "description": {
+                "cpf": {
                     "type": "string"
-                },
-                "end_date": {
+                }
+            }
+        },
+        "handler.UpdateUserCPFResponse": {
+            "type": "object",
+            "properties": {
+                "cpf": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
+                }
+            }
+        },
+        "handler.UpsertCertificateTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "accent_color": {
+                    "type": "string"
                 },
-                "name": {
+                "body_text": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "logo": {
                     "type": "string"
                 },
-                "start_date": {
+                "primary_color": {
                     "type": "string"
                 },
-                "updated_at": {
+                "secondary_color": {
                     "type": "string"
+                },
+                "signatories": {
+                    "type": "array",
+                    "maxItems": 3,
+                    "items": {
+                        "$ref": "#/definitions/handler.CertificateSignatoryRequest"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 60
                 }
             }
         },
-        "handler.EventDetailsResponse": {
+        "handler.UserCertificateResponse": {
             "type": "object",
             "properties": {
-                "activities": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.ActivityWithCheckInsResponse"
-                    }
-                },
-                "allowed_domains": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "activity_id": {
+                    "type": "string"
                 },
-                "created_at": {
+                "activity_name": {
                     "type": "string"
                 },
-                "description": {
+                "event_id": {
                     "type": "string"
                 },
-                "end_date": {
+                "event_name": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "issued_at": {
                     "type": "string"
                 },
-                "start_date": {
+                "pdf_available": {
+                    "type": "boolean"
+                },
+                "revoked_at": {
                     "type": "string"
                 },
-                "updated_at": {
+                "valid": {
+                    "type": "boolean"
+                },
+                "verification_code": {
+                    "type": "string"
+                },
+                "workload": {
                     "type": "string"
+                },
+                "workload_minutes": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.EventResponse": {
+        "handler.UserCheckInResponse": {
             "type": "object",
             "properties": {
-                "allowed_domains": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "activity_end_date": {
+                    "type": "string"
                 },
-                "created_at": {
+                "activity_id": {
                     "type": "string"
                 },
-                "description": {
+                "activity_name": {
                     "type": "string"
                 },
-                "end_date": {
+                "activity_start_date": {
                     "type": "string"
                 },
-                "id": {
+                "attended_minutes": {
+                    "type": "integer"
+                },
+                "certificate_id": {
                     "type": "string"
                 },
-                "name": {
+                "certificate_status": {
                     "type": "string"
                 },
-                "start_date": {
+                "checked_at": {
                     "type": "string"
                 },
-                "updated_at": {
+                "checked_out_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_name": {
+                    "type": "string"
+                },
+                "event_status": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "string"
+                },
+                "manual": {
+                    "type": "boolean"
                 }
             }
         },
-        "handler.EventWithActivitiesResponse": {
+        "handler.VenueRequest": {
             "type": "object",
+            "required": [
+                "latitude",
+                "longitude",
+                "radius_meters"
+            ],
             "properties": {
-                "activities": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.ActivityResponse"
-                    }
+                "latitude": {
+                    "type": "number"
                 },
-                "event": {
-                    "$ref": "#/definitions/handler.EventResponse"
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "radius_meters": {
+                    "type": "integer",
+                    "maximum": 50000,
+                    "minimum": 10
                 }
             }
         },
-        "handler.GetGoogleAuthURLResponse": {
+        "handler.VenueResponse": {
             "type": "object",
             "properties": {
-                "state": {
-                    "type": "string"
+                "latitude": {
+                    "type": "number"
                 },
-                "url": {
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
                     "type": "string"
+                },
+                "radius_meters": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.GoogleCallbackResponse": {
+        "handler.VerifyCertificateResponse": {
             "type": "object",
             "properties": {
-                "access_token": {
+                "activity_id": {
                     "type": "string"
                 },
-                "refresh_token": {
+                "activity_name": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/handler.GoogleCallbackUserResponse"
-                }
-            }
-        },
-        "handler.GoogleCallbackUserResponse": {
-            "type": "object",
-            "properties": {
-                "email": {
+                "event_id": {
                     "type": "string"
                 },
-                "id": {
+                "event_name": {
                     "type": "string"
                 },
-                "name": {
+                "issued_at": {
                     "type": "string"
                 },
-                "role": {
+                "recipient_name": {
                     "type": "string"
-                }
-            }
-        },
-        "handler.RefreshTokenResponse": {
-            "type": "object",
-            "properties": {
-                "access_token": {
+                },
+                "revoked_at": {
                     "type": "string"
                 },
-                "refresh_token": {
+                "revoked_reason": {
+                    "type": "string"
+                },
+                "valid": {
+                    "type": "boolean"
+                },
+                "verification_code": {
+                    "type": "string"
+                },
+                "workload": {
                     "type": "string"
+                },
+                "workload_minutes": {
+                    "type": "integer"
                 }
             }
         },
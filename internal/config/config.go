@@ -1,6 +1,10 @@
 package config
 
-import "github.com/caarlos0/env/v11"
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
 
 type Environment string
 
@@ -17,9 +21,76 @@ type Config struct {
 	GoogleClientID     string      `env:"GOOGLE_CLIENT_ID,required"`
 	GoogleClientSecret string      `env:"GOOGLE_CLIENT_SECRET,required"`
 	GoogleRedirectURL  string      `env:"GOOGLE_REDIRECT_URL" envDefault:"http://localhost:8080/auth/google/callback"`
-	RedisURL   string `env:"REDIS_URL,required"`
-	ResendKey  string `env:"RESEND_KEY,required"`
+	// obrigatório com CERTIFICATE_QUEUE_DRIVER=redis; sem Redis o header Idempotency-Key é ignorado
+	RedisURL      string `env:"REDIS_URL"`
+	PublicBaseURL string `env:"PUBLIC_BASE_URL" envDefault:"http://localhost:8080"`
+	// segredo dos tokens rotativos de check-in; vazio usa o JWT_SECRET
+	CheckInTokenSecret string `env:"CHECKIN_TOKEN_SECRET"`
+	// página do app (ou deep link) aberta pelo QR code de check-in, recebe activity_id e token na query;
+	// vazio faz o QR code conter apenas o token
+	CheckInAppURL string `env:"CHECKIN_APP_URL"`
+	// por quanto tempo as respostas com Idempotency-Key ficam disponíveis para replay
+	IdempotencyTTL time.Duration `env:"IDEMPOTENCY_TTL" envDefault:"24h"`
+	// reserva da chave enquanto a requisição é processada, renovada até o handler terminar;
+	// deve ser maior que o WriteTimeout do servidor
+	IdempotencyLockTTL time.Duration `env:"IDEMPOTENCY_LOCK_TTL" envDefault:"30s"`
+	// email dos avisos aos participantes; sem RESEND_KEY os emails são descartados
+	ResendKey  string `env:"RESEND_KEY"`
 	ResendFrom string `env:"RESEND_FROM" envDefault:"gabriel@laboratorio-de-pesquisa-de-engenharia-de-software.com"`
+	// prazo para confirmar a vaga oferecida a quem sai da lista de espera
+	WaitlistConfirmationWindow time.Duration `env:"WAITLIST_CONFIRMATION_WINDOW" envDefault:"24h"`
+
+	CertificateQueue CertificateQueueConfig
+	Storage          StorageConfig
+}
+
+// WorkerConfig contém as configurações do binário cmd/worker
+type WorkerConfig struct {
+	Env         Environment `env:"ENV" envDefault:"development"`
+	DatabaseURL string      `env:"DATABASE_URL,required"`
+	// obrigatório apenas com CERTIFICATE_QUEUE_DRIVER=redis
+	RedisURL        string        `env:"REDIS_URL"`
+	PublicBaseURL   string        `env:"PUBLIC_BASE_URL" envDefault:"http://localhost:8080"`
+	ResendKey       string        `env:"RESEND_KEY,required"`
+	ResendFrom      string        `env:"RESEND_FROM" envDefault:"gabriel@laboratorio-de-pesquisa-de-engenharia-de-software.com"`
+	Concurrency     int           `env:"WORKER_CONCURRENCY" envDefault:"5"`
+	PollTimeout     time.Duration `env:"WORKER_POLL_TIMEOUT" envDefault:"10s"`
+	HealthPort      int           `env:"WORKER_HEALTH_PORT" envDefault:"8081"`
+	ShutdownTimeout time.Duration `env:"WORKER_SHUTDOWN_TIMEOUT" envDefault:"30s"`
+	// intervalo da varredura que expira vagas não confirmadas e promove a lista de espera
+	WaitlistSweepInterval      time.Duration `env:"WAITLIST_SWEEP_INTERVAL" envDefault:"1m"`
+	WaitlistConfirmationWindow time.Duration `env:"WAITLIST_CONFIRMATION_WINDOW" envDefault:"24h"`
+	// relay que publica na fila os jobs gravados no outbox pelo finish_event
+	OutboxRelayInterval  time.Duration `env:"CERTIFICATE_OUTBOX_RELAY_INTERVAL" envDefault:"2s"`
+	OutboxRelayBatchSize int           `env:"CERTIFICATE_OUTBOX_RELAY_BATCH_SIZE" envDefault:"100"`
+
+	CertificateQueue CertificateQueueConfig
+	Storage          StorageConfig
+}
+
+// CertificateQueueConfig configura o driver (redis ou postgres), retries e visibilidade da fila de certificados
+type CertificateQueueConfig struct {
+	Driver string `env:"CERTIFICATE_QUEUE_DRIVER" envDefault:"redis"`
+	// chave da fila no driver redis
+	Key               string        `env:"CERTIFICATE_QUEUE_KEY" envDefault:"certificate:jobs"`
+	MaxAttempts       int           `env:"CERTIFICATE_QUEUE_MAX_ATTEMPTS" envDefault:"5"`
+	BaseBackoff       time.Duration `env:"CERTIFICATE_QUEUE_BASE_BACKOFF" envDefault:"30s"`
+	MaxBackoff        time.Duration `env:"CERTIFICATE_QUEUE_MAX_BACKOFF" envDefault:"30m"`
+	VisibilityTimeout time.Duration `env:"CERTIFICATE_QUEUE_VISIBILITY_TIMEOUT" envDefault:"5m"`
+	// intervalo entre as consultas à fila vazia no driver postgres
+	PollInterval time.Duration `env:"CERTIFICATE_QUEUE_POLL_INTERVAL" envDefault:"1s"`
+}
+
+// StorageConfig define onde os PDFs dos certificados são armazenados (local ou S3/MinIO)
+type StorageConfig struct {
+	Driver      string `env:"STORAGE_DRIVER" envDefault:"local"`
+	LocalDir    string `env:"STORAGE_LOCAL_DIR" envDefault:"./data/storage"`
+	S3Endpoint  string `env:"STORAGE_S3_ENDPOINT" envDefault:"localhost:9000"`
+	S3Region    string `env:"STORAGE_S3_REGION" envDefault:"us-east-1"`
+	S3Bucket    string `env:"STORAGE_S3_BUCKET" envDefault:"certificates"`
+	S3AccessKey string `env:"STORAGE_S3_ACCESS_KEY"`
+	S3SecretKey string `env:"STORAGE_S3_SECRET_KEY"`
+	S3UseSSL    bool   `env:"STORAGE_S3_USE_SSL" envDefault:"false"`
 }
 
 func Load() (*Config, error) {
@@ -31,3 +102,13 @@ func Load() (*Config, error) {
 
 	return cfg, nil
 }
+
+func LoadWorker() (*WorkerConfig, error) {
+	cfg := &WorkerConfig{}
+
+	if err := env.Parse(cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,166 @@
+package cancelevent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+	"go.uber.org/zap"
+)
+
+// tempo máximo para enviar os avisos de cancelamento em background
+const notificationTimeout = 10 * time.Minute
+
+type Input struct {
+	UserID  string
+	EventID string
+	// Reason é opcional e vai no aviso enviado aos participantes
+	Reason string
+}
+
+type Output struct {
+	Event *entity.Event
+	// participantes com check-in no evento; os avisos por email são enviados em background
+	Attendees int
+}
+
+type UseCase struct {
+	eventRepo    repository.EventRepository
+	activityRepo repository.ActivityRepository
+	checkInRepo  repository.CheckInRepository
+	userAuthSvc  service.UserAuthorizationService
+	notifier     service.AttendeeNotifier
+	logger       *zap.Logger
+}
+
+func NewUseCase(
+	eventRepo repository.EventRepository,
+	activityRepo repository.ActivityRepository,
+	checkInRepo repository.CheckInRepository,
+	userAuthSvc service.UserAuthorizationService,
+	notifier service.AttendeeNotifier,
+	logger *zap.Logger,
+) *UseCase {
+	return &UseCase{
+		eventRepo:    eventRepo,
+		activityRepo: activityRepo,
+		checkInRepo:  checkInRepo,
+		userAuthSvc:  userAuthSvc,
+		notifier:     notifier,
+		logger:       logger,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// 1. Verificar se é admin
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	// 2. Buscar evento e validar a transição
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	if err := event.Cancel(); err != nil {
+		return nil, err
+	}
+
+	// 3. Persistir o cancelamento
+	//nolint:exhaustruct
+	updated, err := uc.eventRepo.PartialUpdate(ctx, event.ID, repository.UpdateEventInput{
+		Status: &event.Status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update event status: %w", err)
+	}
+
+	// 4. Avisar quem fez check-in no evento
+	attendees, err := uc.findAttendees(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(attendees) > 0 {
+		go uc.notifyAttendees(context.WithoutCancel(ctx), updated, input.Reason, attendees)
+	}
+
+	return &Output{
+		Event:     updated,
+		Attendees: len(attendees),
+	}, nil
+}
+
+// notifyAttendees envia os avisos sem prender a requisição; o evento já está cancelado,
+// então as falhas de envio são apenas registradas no log
+func (uc *UseCase) notifyAttendees(ctx context.Context, event *entity.Event, reason string, attendees []*service.UserInfo) {
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	sent, err := uc.notifier.NotifyEventCancelled(ctx, event, reason, attendees)
+	if err != nil {
+		uc.logger.Warn("failed to notify some attendees of event cancellation",
+			zap.String("event_id", event.ID),
+			zap.Int("attendees", len(attendees)),
+			zap.Int("notified", sent),
+			zap.Error(err),
+		)
+		return
+	}
+
+	uc.logger.Info("attendees notified of event cancellation",
+		zap.String("event_id", event.ID),
+		zap.Int("notified", sent),
+	)
+}
+
+// findAttendees retorna os usuários com check-in ativo em alguma atividade do evento
+func (uc *UseCase) findAttendees(ctx context.Context, eventID string) ([]*service.UserInfo, error) {
+	activities, err := uc.activityRepo.FindByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find activities by event ID: %w", err)
+	}
+	if len(activities) == 0 {
+		return nil, nil
+	}
+
+	activityIDs := make([]string, len(activities))
+	for i, activity := range activities {
+		activityIDs[i] = activity.ID
+	}
+
+	checkIns, err := uc.checkInRepo.FindByActivityIDs(ctx, activityIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find check-ins by activity IDs: %w", err)
+	}
+
+	seen := make(map[string]struct{}, len(checkIns))
+	userIDs := make([]string, 0, len(checkIns))
+	for _, checkIn := range checkIns {
+		if _, ok := seen[checkIn.UserID]; !ok {
+			seen[checkIn.UserID] = struct{}{}
+			userIDs = append(userIDs, checkIn.UserID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	users, err := uc.userAuthSvc.GetUserInfoBatch(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user info batch: %w", err)
+	}
+
+	return users, nil
+}
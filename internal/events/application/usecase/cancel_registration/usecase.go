@@ -0,0 +1,171 @@
+package cancelregistration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	processwaitlist "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/process_waitlist"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+	"go.uber.org/zap"
+)
+
+type Input struct {
+	UserID         string
+	EventID        string
+	RegistrationID string
+}
+
+type Output struct {
+	Registration *entity.Registration
+}
+
+type UseCase struct {
+	txProvider       repository.TransactionProvider
+	registrationRepo repository.RegistrationRepository
+	userAuthSvc      service.UserAuthorizationService
+	processWaitlist  *processwaitlist.UseCase
+	logger           *zap.Logger
+}
+
+func NewUseCase(
+	txProvider repository.TransactionProvider,
+	registrationRepo repository.RegistrationRepository,
+	userAuthSvc service.UserAuthorizationService,
+	processWaitlist *processwaitlist.UseCase,
+	logger *zap.Logger,
+) *UseCase {
+	return &UseCase{
+		txProvider:       txProvider,
+		registrationRepo: registrationRepo,
+		userAuthSvc:      userAuthSvc,
+		processWaitlist:  processWaitlist,
+		logger:           logger,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// 1. Buscar inscrição
+	registration, err := uc.registrationRepo.FindByID(ctx, input.RegistrationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find registration: %w", err)
+	}
+	if registration == nil || registration.EventID != input.EventID {
+		return nil, fmt.Errorf("registration not found")
+	}
+
+	// 2. O participante cancela a própria inscrição; admins cancelam qualquer uma
+	if registration.UserID != input.UserID {
+		isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check user role: %w", err)
+		}
+		if !isAdmin {
+			return nil, fmt.Errorf("registration not found")
+		}
+	}
+
+	now := time.Now()
+
+	// 3. Cancelar a inscrição no evento cancela também as inscrições nas atividades
+	var (
+		cancelled *entity.Registration
+		// atividades com vagas liberadas
+		activityIDs []string
+	)
+	err = uc.txProvider.Transact(ctx, func(repos repository.Repositories) error {
+		// bloqueia as atividades afetadas, na mesma ordem que a lista de espera e a confirmação de vagas,
+		// para não concorrer com a promoção ou a confirmação de uma dessas inscrições
+		lockIDs, err := uc.activitiesToLock(ctx, repos, registration)
+		if err != nil {
+			return err
+		}
+		for _, activityID := range lockIDs {
+			if _, err := repos.Activities.FindByIDForUpdate(ctx, activityID); err != nil {
+				return fmt.Errorf("failed to find activity: %w", err)
+			}
+		}
+
+		// relê a inscrição bloqueada; o status pode ter mudado depois da primeira leitura
+		current, err := repos.Registrations.FindByIDForUpdate(ctx, registration.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find registration: %w", err)
+		}
+		if current == nil {
+			return fmt.Errorf("registration not found")
+		}
+
+		if err := current.Cancel(now); err != nil {
+			return err
+		}
+
+		cancelled, err = repos.Registrations.UpdateStatus(ctx, current)
+		if err != nil {
+			return fmt.Errorf("failed to cancel registration: %w", err)
+		}
+
+		if current.IsForActivity() {
+			activityIDs = append(activityIDs, *current.ActivityID)
+			return nil
+		}
+
+		others, err := repos.Registrations.FindByUserAndEvent(ctx, current.UserID, current.EventID)
+		if err != nil {
+			return fmt.Errorf("failed to find registrations: %w", err)
+		}
+		for _, other := range others {
+			if other.IsForActivity() && other.HoldsSeat() {
+				activityIDs = append(activityIDs, *other.ActivityID)
+			}
+		}
+
+		if _, err := repos.Registrations.CancelByUserAndEvent(ctx, registration.UserID, registration.EventID, now); err != nil {
+			return fmt.Errorf("failed to cancel activity registrations: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Oferecer as vagas liberadas aos próximos da lista de espera
+	// o cancelamento já foi gravado; se falhar aqui, a varredura periódica da lista de espera refaz o processamento
+	for _, activityID := range activityIDs {
+		if _, err := uc.processWaitlist.Execute(ctx, &processwaitlist.Input{ActivityID: activityID}); err != nil {
+			uc.logger.Warn("failed to process waitlist after cancellation",
+				zap.String("registration_id", cancelled.ID),
+				zap.String("activity_id", activityID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return &Output{Registration: cancelled}, nil
+}
+
+// activitiesToLock retorna, em ordem, as atividades cujas vagas o cancelamento pode liberar
+func (uc *UseCase) activitiesToLock(ctx context.Context, repos repository.Repositories, registration *entity.Registration) ([]string, error) {
+	if registration.IsForActivity() {
+		return []string{*registration.ActivityID}, nil
+	}
+
+	others, err := repos.Registrations.FindByUserAndEvent(ctx, registration.UserID, registration.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find registrations: %w", err)
+	}
+
+	activityIDs := make([]string, 0, len(others))
+	for _, other := range others {
+		if other.IsForActivity() {
+			activityIDs = append(activityIDs, *other.ActivityID)
+		}
+	}
+
+	// ordem fixa evita deadlock entre cancelamentos simultâneos
+	slices.Sort(activityIDs)
+	return slices.Compact(activityIDs), nil
+}
@@ -2,7 +2,10 @@ package checkinactivity
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
 	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
@@ -13,6 +16,22 @@ import (
 type Input struct {
 	UserID     string
 	ActivityID string
+	// Token é o código rotativo do QR code, obrigatório quando a atividade exige check-in presencial
+	Token string
+	// coordenadas do participante, obrigatórias quando a atividade ou o evento tem local configurado
+	Latitude  *float64
+	Longitude *float64
+	// RegisteredBy é o admin que registra o check-in em nome do participante (check-in manual)
+	// o check-in manual dispensa token e localização, mas mantém as demais regras do evento
+	RegisteredBy string
+	Reason       string
+	// OverrideTimeWindow permite ao admin registrar o check-in fora da janela da atividade
+	OverrideTimeWindow bool
+}
+
+// IsManual indica se o check-in está sendo registrado por um admin
+func (i *Input) IsManual() bool {
+	return i.RegisteredBy != ""
 }
 
 type Output struct {
@@ -20,10 +39,13 @@ type Output struct {
 }
 
 type UseCase struct {
-	checkInRepo  repository.CheckInRepository
-	activityRepo repository.ActivityRepository
-	eventRepo    repository.EventRepository
-	userAuthSvc  service.UserAuthorizationService
+	checkInRepo      repository.CheckInRepository
+	activityRepo     repository.ActivityRepository
+	eventRepo        repository.EventRepository
+	userAuthSvc      service.UserAuthorizationService
+	tokenSvc         service.CheckInTokenService
+	rejectionRepo    repository.CheckInRejectionRepository
+	registrationRepo repository.RegistrationRepository
 }
 
 func NewUseCase(
@@ -31,12 +53,18 @@ func NewUseCase(
 	activityRepo repository.ActivityRepository,
 	eventRepo repository.EventRepository,
 	userAuthSvc service.UserAuthorizationService,
+	tokenSvc service.CheckInTokenService,
+	rejectionRepo repository.CheckInRejectionRepository,
+	registrationRepo repository.RegistrationRepository,
 ) *UseCase {
 	return &UseCase{
-		checkInRepo:  checkInRepo,
-		activityRepo: activityRepo,
-		eventRepo:    eventRepo,
-		userAuthSvc:  userAuthSvc,
+		checkInRepo:      checkInRepo,
+		activityRepo:     activityRepo,
+		eventRepo:        eventRepo,
+		userAuthSvc:      userAuthSvc,
+		tokenSvc:         tokenSvc,
+		rejectionRepo:    rejectionRepo,
+		registrationRepo: registrationRepo,
 	}
 }
 
@@ -76,16 +104,27 @@ func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
 
 	// 3. Verificar se já fez check-in
 	if existing != nil {
-		return nil, fmt.Errorf("user already checked in")
+		return nil, entity.ErrAlreadyCheckedIn
+	}
+
+	// 4. Verificar se está dentro da janela de check-in da atividade
+	if !input.IsManual() || !input.OverrideTimeWindow {
+		if err := activity.ValidateCheckInTime(time.Now()); err != nil {
+			return nil, err
+		}
 	}
 
-	// 4. Verificar se está no horário da atividade
-	// TODO: descomentar após testes
-	// if !activity.IsCheckInAllowed(time.Now()) {
-	// 	return nil, fmt.Errorf("check-in not allowed outside activity time")
-	// }
+	// 5. Validar token rotativo do QR code
+	if activity.CheckInTokenRequired && !input.IsManual() {
+		if input.Token == "" {
+			return nil, fmt.Errorf("check-in token required")
+		}
+		if !uc.tokenSvc.Verify(activity.ID, input.Token, activity.CheckInTokenPeriod(), time.Now()) {
+			return nil, fmt.Errorf("invalid check-in token")
+		}
+	}
 
-	// 5. Busca evento e email do usuário em paralelo
+	// 6. Busca evento e email do usuário em paralelo
 	var event *entity.Event
 	var userEmail string
 
@@ -117,29 +156,132 @@ func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
 		return nil, fmt.Errorf("event not found")
 	}
 
+	// só eventos publicados recebem check-in (rascunhos, cancelados e finalizados não)
+	if !event.AcceptsCheckIns() {
+		return nil, entity.ErrEventNotOpenForCheckIn
+	}
+
 	if userEmail == "" {
 		return nil, fmt.Errorf("user email not found")
 	}
 
-	// 6. Verificar se o dominio do usuario está permitido
+	// 7. Verificar se o dominio do usuario está permitido
 	if !event.IsAllowedDomain(userEmail) {
 		return nil, fmt.Errorf("user domain not allowed")
 	}
 
-	// 7. Criar check-in
-	checkIn, err := entity.NewCheckIn(entity.NewCheckInParams{
-		UserID:     input.UserID,
-		ActivityID: input.ActivityID,
-	})
+	// 8. Verificar a inscrição quando o evento ou a atividade exigem
+	// o check-in manual dispensa a inscrição, o admin decide quem entra
+	if !input.IsManual() {
+		if err := uc.checkRegistration(ctx, input.UserID, event, activity); err != nil {
+			return nil, err
+		}
+	}
+
+	// 9. Verificar se o participante está no local da atividade
+	if !input.IsManual() {
+		if err := uc.checkVenue(ctx, input, event.CheckInVenue(activity)); err != nil {
+			return nil, err
+		}
+	}
+
+	// 10. Criar check-in
+	params := entity.NewCheckInParams{
+		UserID:             input.UserID,
+		ActivityID:         input.ActivityID,
+		RegisteredBy:       nil,
+		RegistrationReason: nil,
+	}
+	if input.IsManual() {
+		params.RegisteredBy = &input.RegisteredBy
+		params.RegistrationReason = &input.Reason
+	}
+
+	checkIn, err := entity.NewCheckIn(params)
 	if err != nil {
 		return nil, err
 	}
 
-	// 8. Salvar
+	// 11. Salvar
 	saved, err := uc.checkInRepo.Save(ctx, checkIn)
 	if err != nil {
+		if errors.Is(err, entity.ErrAlreadyCheckedIn) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("failed to save check-in: %w", err)
 	}
 
 	return &Output{CheckIn: saved}, nil
 }
+
+// checkRegistration exige inscrição no evento quando ele está configurado para isso
+// e inscrição confirmada na atividade quando ela tem capacidade limitada (quem está na lista de espera não entra)
+func (uc *UseCase) checkRegistration(ctx context.Context, userID string, event *entity.Event, activity *entity.Activity) error {
+	if !event.RegistrationRequired && !activity.HasCapacity() {
+		return nil
+	}
+
+	registrations, err := uc.registrationRepo.FindByUserAndEvent(ctx, userID, event.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find registrations: %w", err)
+	}
+
+	if event.RegistrationRequired && !slices.ContainsFunc(registrations, func(r *entity.Registration) bool {
+		return !r.IsForActivity()
+	}) {
+		return entity.ErrRegistrationRequired
+	}
+
+	if activity.HasCapacity() && !slices.ContainsFunc(registrations, func(r *entity.Registration) bool {
+		return r.ActivityID != nil && *r.ActivityID == activity.ID && r.Status == entity.RegistrationStatusConfirmed
+	}) {
+		return fmt.Errorf("%w: activity has limited capacity", entity.ErrRegistrationRequired)
+	}
+
+	return nil
+}
+
+// checkVenue recusa o check-in fora da cerca geográfica e registra a tentativa para auditoria
+func (uc *UseCase) checkVenue(ctx context.Context, input *Input, venue entity.Venue) error {
+	if !venue.HasVenue() {
+		return nil
+	}
+
+	var (
+		reason   entity.CheckInRejectionReason
+		distance *float64
+		cause    error
+	)
+
+	switch {
+	case input.Latitude == nil || input.Longitude == nil:
+		reason = entity.CheckInRejectionLocationMissing
+		cause = entity.ErrCheckInLocationRequired
+	default:
+		d := venue.VenueDistance(*input.Latitude, *input.Longitude)
+		if venue.IsWithinVenue(*input.Latitude, *input.Longitude) {
+			return nil
+		}
+		reason = entity.CheckInRejectionOutsideVenue
+		distance = &d
+		cause = fmt.Errorf("%w: %.0f meters away", entity.ErrCheckInOutsideVenue, d)
+	}
+
+	rejection, err := entity.NewCheckInRejection(entity.NewCheckInRejectionParams{
+		UserID:         input.UserID,
+		ActivityID:     input.ActivityID,
+		Reason:         reason,
+		Latitude:       input.Latitude,
+		Longitude:      input.Longitude,
+		DistanceMeters: distance,
+	})
+	if err != nil {
+		return errors.Join(cause, err)
+	}
+
+	if _, err := uc.rejectionRepo.Save(ctx, rejection); err != nil {
+		return errors.Join(cause, fmt.Errorf("failed to save check-in rejection: %w", err))
+	}
+
+	return cause
+}
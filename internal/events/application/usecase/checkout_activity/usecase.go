@@ -0,0 +1,84 @@
+package checkoutactivity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"golang.org/x/sync/errgroup"
+)
+
+type Input struct {
+	UserID     string
+	ActivityID string
+}
+
+type Output struct {
+	CheckIn *entity.CheckIn
+}
+
+type UseCase struct {
+	checkInRepo  repository.CheckInRepository
+	activityRepo repository.ActivityRepository
+}
+
+func NewUseCase(checkInRepo repository.CheckInRepository, activityRepo repository.ActivityRepository) *UseCase {
+	return &UseCase{
+		checkInRepo:  checkInRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	var activity *entity.Activity
+	var checkIn *entity.CheckIn
+
+	// 1. Buscar atividade e check-in do usuário em paralelo
+	g, gCtx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		var err error
+		activity, err = uc.activityRepo.FindByID(gCtx, input.ActivityID)
+		if err != nil {
+			return fmt.Errorf("failed to find activity: %w", err)
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		var err error
+		checkIn, err = uc.checkInRepo.FindByUserAndActivity(gCtx, input.UserID, input.ActivityID)
+		if err != nil {
+			return fmt.Errorf("failed to find check-in: %w", err)
+		}
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	if activity == nil {
+		return nil, fmt.Errorf("activity not found")
+	}
+
+	// 2. Só é possível fazer check-out de quem fez check-in
+	if checkIn == nil {
+		return nil, fmt.Errorf("check-in not found")
+	}
+
+	// 3. Registrar saída e tempo de permanência
+	if err := checkIn.CheckOut(activity, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// 4. Salvar
+	saved, err := uc.checkInRepo.CheckOut(ctx, checkIn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save check-out: %w", err)
+	}
+
+	return &Output{CheckIn: saved}, nil
+}
@@ -0,0 +1,76 @@
+package confirmregistration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+)
+
+type Input struct {
+	UserID         string
+	EventID        string
+	RegistrationID string
+}
+
+type Output struct {
+	Registration *entity.Registration
+}
+
+type UseCase struct {
+	txProvider repository.TransactionProvider
+}
+
+func NewUseCase(txProvider repository.TransactionProvider) *UseCase {
+	return &UseCase{
+		txProvider: txProvider,
+	}
+}
+
+// Execute confirma a vaga oferecida a quem estava na lista de espera
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	var confirmed *entity.Registration
+
+	err := uc.txProvider.Transact(ctx, func(repos repository.Repositories) error {
+		registration, err := repos.Registrations.FindByID(ctx, input.RegistrationID)
+		if err != nil {
+			return fmt.Errorf("failed to find registration: %w", err)
+		}
+		if registration == nil || registration.EventID != input.EventID || registration.UserID != input.UserID {
+			return fmt.Errorf("registration not found")
+		}
+		if !registration.IsForActivity() {
+			return entity.ErrRegistrationNotOffered
+		}
+
+		// bloqueia a atividade para não concorrer com a expiração da vaga; relê a inscrição já bloqueada
+		if _, err := repos.Activities.FindByIDForUpdate(ctx, *registration.ActivityID); err != nil {
+			return fmt.Errorf("failed to find activity: %w", err)
+		}
+		registration, err = repos.Registrations.FindByID(ctx, registration.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find registration: %w", err)
+		}
+		if registration == nil {
+			return fmt.Errorf("registration not found")
+		}
+
+		if err := registration.Confirm(time.Now()); err != nil {
+			return err
+		}
+
+		confirmed, err = repos.Registrations.UpdateStatus(ctx, registration)
+		if err != nil {
+			return fmt.Errorf("failed to confirm registration: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Output{Registration: confirmed}, nil
+}
@@ -3,6 +3,7 @@ package createactivities
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
@@ -15,6 +16,12 @@ type ActivityInput struct {
 	Description *string
 	StartDate   time.Time
 	EndDate     time.Time
+	// trilha (sala) opcional
+	Track *string
+	// número máximo de inscritos; nil é sem limite
+	Capacity *int
+	// local opcional da atividade; sem local, vale o do evento
+	Venue *entity.NewVenueParams
 }
 
 type Input struct {
@@ -72,6 +79,14 @@ func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
 		names = append(names, a.Name)
 	}
 
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
 	// Check if activities with these names already exist for this event
 	existing, err := uc.activityRepo.FindByEventIDAndNames(ctx, input.EventID, names)
 	if err != nil {
@@ -84,12 +99,23 @@ func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
 	// Create all activities
 	activities := make([]*entity.Activity, 0, len(input.Activities))
 	for _, a := range input.Activities {
+		var venue entity.Venue
+		if a.Venue != nil {
+			venue, err = entity.NewVenue(*a.Venue)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		activity, err := entity.NewActivity(entity.NewActivityParams{
 			Name:        a.Name,
 			EventID:     input.EventID,
 			Description: a.Description,
 			StartDate:   a.StartDate,
 			EndDate:     a.EndDate,
+			Track:       a.Track,
+			Capacity:    a.Capacity,
+			Venue:       venue,
 		})
 		if err != nil {
 			return nil, err
@@ -97,6 +123,27 @@ func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
 		activities = append(activities, activity)
 	}
 
+	// Validate dates against the event and the other activities of the same track
+	eventActivities, err := uc.activityRepo.FindByEventID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find activities by event ID: %w", err)
+	}
+
+	var invalid []entity.ActivityValidationError
+	for i, activity := range activities {
+		// compara com as já cadastradas e com as anteriores do lote
+		if errs := activity.ValidateSchedule(event, slices.Concat(eventActivities, activities[:i])); len(errs) > 0 {
+			invalid = append(invalid, entity.ActivityValidationError{
+				Index:  i,
+				Name:   activity.Name,
+				Errors: errs,
+			})
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, &entity.ActivityBatchError{Activities: invalid}
+	}
+
 	saved, err := uc.activityRepo.SaveAll(ctx, activities)
 	if err != nil {
 		return nil, err
@@ -17,6 +17,8 @@ type Input struct {
 	Description    *string
 	StartDate      time.Time
 	EndDate        time.Time
+	// local opcional do evento, usado como cerca geográfica no check-in
+	Venue *entity.NewVenueParams
 }
 
 type Output struct {
@@ -50,12 +52,21 @@ func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
 		return nil, fmt.Errorf("user is not an admin")
 	}
 
+	var venue entity.Venue
+	if input.Venue != nil {
+		venue, err = entity.NewVenue(*input.Venue)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	event, err := entity.NewEvent(entity.NewEventParams{
 		Name:           input.Name,
 		AllowedDomains: input.AllowedDomains,
 		Description:    input.Description,
 		StartDate:      input.StartDate,
 		EndDate:        input.EndDate,
+		Venue:          venue,
 	})
 	if err != nil {
 		return nil, err
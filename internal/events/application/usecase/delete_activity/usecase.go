@@ -0,0 +1,96 @@
+package deleteactivity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+type Input struct {
+	UserID     string
+	ActivityID string
+	// Force remove a atividade mesmo com check-ins, apagando os check-ins junto
+	Force bool
+}
+
+type UseCase struct {
+	txProvider      repository.TransactionProvider
+	activityRepo    repository.ActivityRepository
+	checkInRepo     repository.CheckInRepository
+	certificateRepo repository.CertificateRepository
+	userAuthSvc     service.UserAuthorizationService
+}
+
+func NewUseCase(
+	txProvider repository.TransactionProvider,
+	activityRepo repository.ActivityRepository,
+	checkInRepo repository.CheckInRepository,
+	certificateRepo repository.CertificateRepository,
+	userAuthSvc service.UserAuthorizationService,
+) *UseCase {
+	return &UseCase{
+		txProvider:      txProvider,
+		activityRepo:    activityRepo,
+		checkInRepo:     checkInRepo,
+		certificateRepo: certificateRepo,
+		userAuthSvc:     userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) error {
+	// 1. Verificar se é admin
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return fmt.Errorf("user is not an admin")
+	}
+
+	// 2. Buscar atividade com o evento
+	result, err := uc.activityRepo.FindByActivityIDWithEvent(ctx, input.ActivityID)
+	if err != nil {
+		return fmt.Errorf("failed to find activity: %w", err)
+	}
+	if result == nil {
+		return fmt.Errorf("activity not found")
+	}
+
+	if !result.Event.IsEditable() {
+		return entity.ErrEventNotEditable
+	}
+
+	// 3. Certificados emitidos precisam continuar verificáveis
+	hasCertificates, err := uc.certificateRepo.ExistsByActivityID(ctx, input.ActivityID)
+	if err != nil {
+		return fmt.Errorf("failed to check activity certificates: %w", err)
+	}
+	if hasCertificates {
+		return fmt.Errorf("activity has issued certificates")
+	}
+
+	// 4. Check-ins só são apagados com force
+	if !input.Force {
+		checkIns, err := uc.checkInRepo.FindByActivityID(ctx, input.ActivityID)
+		if err != nil {
+			return fmt.Errorf("failed to find check-ins: %w", err)
+		}
+		if len(checkIns) > 0 {
+			return fmt.Errorf("activity has check-ins")
+		}
+	}
+
+	// 5. Remover check-ins e atividade em transaction
+	return uc.txProvider.Transact(ctx, func(repos repository.Repositories) error {
+		if err := repos.CheckIns.DeleteByActivityIDs(ctx, []string{input.ActivityID}); err != nil {
+			return fmt.Errorf("failed to delete check-ins: %w", err)
+		}
+		if err := repos.Activities.Delete(ctx, input.ActivityID); err != nil {
+			return fmt.Errorf("failed to delete activity: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,63 @@
+package deletecertificatetemplate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/storage"
+)
+
+type Input struct {
+	UserID  string
+	EventID string
+}
+
+type UseCase struct {
+	templateRepo repository.CertificateTemplateRepository
+	blobStorage  storage.BlobStorage
+	userAuthSvc  service.UserAuthorizationService
+}
+
+func NewUseCase(
+	templateRepo repository.CertificateTemplateRepository,
+	blobStorage storage.BlobStorage,
+	userAuthSvc service.UserAuthorizationService,
+) *UseCase {
+	return &UseCase{
+		templateRepo: templateRepo,
+		blobStorage:  blobStorage,
+		userAuthSvc:  userAuthSvc,
+	}
+}
+
+// Execute remove o template do evento, que volta a usar o template padrão
+func (uc *UseCase) Execute(ctx context.Context, input *Input) error {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return fmt.Errorf("user is not an admin")
+	}
+
+	template, err := uc.templateRepo.FindByEventID(ctx, input.EventID)
+	if err != nil {
+		return fmt.Errorf("failed to find certificate template: %w", err)
+	}
+	if template == nil {
+		return fmt.Errorf("certificate template not found")
+	}
+
+	if err := uc.templateRepo.DeleteByEventID(ctx, input.EventID); err != nil {
+		return fmt.Errorf("failed to delete certificate template: %w", err)
+	}
+
+	// remoção das imagens é best-effort: o template já foi removido
+	for _, key := range template.ImageKeys() {
+		_ = uc.blobStorage.Delete(ctx, key)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package deleteevent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+type Input struct {
+	UserID  string
+	EventID string
+	// Force remove o evento mesmo com check-ins, apagando os check-ins junto
+	Force bool
+}
+
+type UseCase struct {
+	txProvider      repository.TransactionProvider
+	eventRepo       repository.EventRepository
+	activityRepo    repository.ActivityRepository
+	checkInRepo     repository.CheckInRepository
+	certificateRepo repository.CertificateRepository
+	userAuthSvc     service.UserAuthorizationService
+}
+
+func NewUseCase(
+	txProvider repository.TransactionProvider,
+	eventRepo repository.EventRepository,
+	activityRepo repository.ActivityRepository,
+	checkInRepo repository.CheckInRepository,
+	certificateRepo repository.CertificateRepository,
+	userAuthSvc service.UserAuthorizationService,
+) *UseCase {
+	return &UseCase{
+		txProvider:      txProvider,
+		eventRepo:       eventRepo,
+		activityRepo:    activityRepo,
+		checkInRepo:     checkInRepo,
+		certificateRepo: certificateRepo,
+		userAuthSvc:     userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) error {
+	// 1. Verificar se é admin
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return fmt.Errorf("user is not an admin")
+	}
+
+	// 2. Buscar evento
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return fmt.Errorf("event not found")
+	}
+
+	if !event.IsEditable() {
+		return entity.ErrEventNotEditable
+	}
+
+	// 3. Certificados emitidos precisam continuar verificáveis
+	hasCertificates, err := uc.certificateRepo.ExistsByEventID(ctx, event.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check event certificates: %w", err)
+	}
+	if hasCertificates {
+		return fmt.Errorf("event has issued certificates")
+	}
+
+	// 4. Check-ins só são apagados com force
+	activities, err := uc.activityRepo.FindByEventID(ctx, event.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find activities by event ID: %w", err)
+	}
+
+	activityIDs := make([]string, len(activities))
+	for i, activity := range activities {
+		activityIDs[i] = activity.ID
+	}
+
+	if len(activityIDs) > 0 && !input.Force {
+		checkIns, err := uc.checkInRepo.FindByActivityIDs(ctx, activityIDs)
+		if err != nil {
+			return fmt.Errorf("failed to find check-ins by activity IDs: %w", err)
+		}
+		if len(checkIns) > 0 {
+			return fmt.Errorf("event has check-ins")
+		}
+	}
+
+	// 5. Remover check-ins, atividades e evento em transaction
+	return uc.txProvider.Transact(ctx, func(repos repository.Repositories) error {
+		if len(activityIDs) > 0 {
+			if err := repos.CheckIns.DeleteByActivityIDs(ctx, activityIDs); err != nil {
+				return fmt.Errorf("failed to delete check-ins: %w", err)
+			}
+		}
+		if err := repos.Activities.DeleteByEventID(ctx, event.ID); err != nil {
+			return fmt.Errorf("failed to delete activities: %w", err)
+		}
+		if err := repos.Events.Delete(ctx, event.ID); err != nil {
+			return fmt.Errorf("failed to delete event: %w", err)
+		}
+		return nil
+	})
+}
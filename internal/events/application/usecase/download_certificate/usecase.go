@@ -0,0 +1,80 @@
+package downloadcertificate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/storage"
+)
+
+type Input struct {
+	CertificateID string
+	UserID        string
+}
+
+type Output struct {
+	Filename string
+	Content  []byte
+}
+
+type UseCase struct {
+	certificateRepo repository.CertificateRepository
+	blobStorage     storage.BlobStorage
+	userAuthSvc     service.UserAuthorizationService
+}
+
+func NewUseCase(
+	certificateRepo repository.CertificateRepository,
+	blobStorage storage.BlobStorage,
+	userAuthSvc service.UserAuthorizationService,
+) *UseCase {
+	return &UseCase{
+		certificateRepo: certificateRepo,
+		blobStorage:     blobStorage,
+		userAuthSvc:     userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	certificate, err := uc.certificateRepo.FindByID(ctx, input.CertificateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find certificate: %w", err)
+	}
+
+	// certificados revogados não podem mais ser baixados
+	if certificate == nil || !certificate.IsValid() {
+		return nil, fmt.Errorf("certificate not found")
+	}
+
+	// apenas o dono do certificado ou um admin podem baixar o PDF
+	if certificate.UserID != input.UserID {
+		isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check user role: %w", err)
+		}
+		if !isAdmin {
+			// não revela a existência de certificados de outros usuários
+			return nil, fmt.Errorf("certificate not found")
+		}
+	}
+
+	if !certificate.HasFile() {
+		return nil, fmt.Errorf("certificate file not available")
+	}
+
+	content, err := uc.blobStorage.Get(ctx, *certificate.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, fmt.Errorf("certificate file not available")
+		}
+		return nil, fmt.Errorf("failed to load certificate file: %w", err)
+	}
+
+	return &Output{
+		Filename: fmt.Sprintf("certificado-%s.pdf", certificate.VerificationCode),
+		Content:  content,
+	}, nil
+}
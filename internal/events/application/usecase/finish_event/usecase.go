@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
 	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
@@ -17,39 +19,54 @@ type Input struct {
 	UserID  string `json:"user_id" validate:"required"`
 }
 
+type Output struct {
+	// EnqueuedJobs conta apenas os certificados novos; ExistingCertificates, os de finalizações anteriores
+	EnqueuedJobs         int                `json:"enqueued_jobs"`
+	ExistingCertificates int                `json:"existing_certificates"`
+	QualifiedUsers       int                `json:"qualified_users"`
+	NotQualified         []NotQualifiedUser `json:"not_qualified"`
+}
+
+// NotQualifiedUser representa um participante que não atingiu a frequência mínima
+type NotQualifiedUser struct {
+	UserID             string `json:"user_id"`
+	Name               string `json:"name"`
+	Email              string `json:"email"`
+	AttendedActivities int    `json:"attended_activities"`
+	AttendedMinutes    int    `json:"attended_minutes"`
+}
+
 type UseCase struct {
-	eventRepo        repository.EventRepository
-	txProvider       repository.TransactionProvider
-	activityRepo     repository.ActivityRepository
-	checkInRepo      repository.CheckInRepository
-	userAuthSvc      service.UserAuthorizationService
-	certificateQueue queue.CertificateQueue
+	eventRepo    repository.EventRepository
+	txProvider   repository.TransactionProvider
+	activityRepo repository.ActivityRepository
+	checkInRepo  repository.CheckInRepository
+	userAuthSvc  service.UserAuthorizationService
 }
 
-func NewUseCase(txProvider repository.TransactionProvider, eventRepo repository.EventRepository, activityRepo repository.ActivityRepository, checkInRepo repository.CheckInRepository, userAuthSvc service.UserAuthorizationService, certificateQueue queue.CertificateQueue) *UseCase {
+func NewUseCase(txProvider repository.TransactionProvider, eventRepo repository.EventRepository, activityRepo repository.ActivityRepository, checkInRepo repository.CheckInRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
 	return &UseCase{
-		txProvider:       txProvider,
-		eventRepo:        eventRepo,
-		activityRepo:     activityRepo,
-		checkInRepo:      checkInRepo,
-		userAuthSvc:      userAuthSvc,
-		certificateQueue: certificateQueue,
+		txProvider:   txProvider,
+		eventRepo:    eventRepo,
+		activityRepo: activityRepo,
+		checkInRepo:  checkInRepo,
+		userAuthSvc:  userAuthSvc,
 	}
 }
 
-func (uc *UseCase) Execute(ctx context.Context, input *Input) error {
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
 
 	user, err := uc.userAuthSvc.GetUserByID(ctx, input.UserID)
 	if err != nil {
-		return fmt.Errorf("failed to get user by ID: %w", err)
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
 	}
 
 	if user == nil {
-		return errors.New("user not found")
+		return nil, errors.New("user not found")
 	}
 
 	if !user.IsAdmin {
-		return errors.New("user is not an admin")
+		return nil, errors.New("user is not an admin")
 	}
 
 	var (
@@ -78,22 +95,31 @@ func (uc *UseCase) Execute(ctx context.Context, input *Input) error {
 	})
 
 	if err := g.Wait(); err != nil {
-		return err
+		return nil, err
 	}
 
 	if event == nil {
-		return errors.New("event not found")
+		return nil, errors.New("event not found")
+	}
+
+	// apenas eventos publicados podem ser finalizados; finalizar de novo um evento
+	// já finalizado é permitido e só agenda os certificados que ainda não existem
+	alreadyCompleted := event.Status == entity.EventStatusCompleted
+	if !alreadyCompleted {
+		if err := event.Complete(); err != nil {
+			return nil, err
+		}
 	}
 
 	if len(activities) == 0 {
-		return errors.New("no activities found for event")
+		return nil, errors.New("no activities found for event")
 	}
 
 	activityIDs := make([]string, len(activities))
 	for i, activity := range activities {
 		activityIDs[i] = activity.ID
 		if !activity.HasEnded() {
-			return errors.New("activity has not ended")
+			return nil, errors.New("activity has not ended")
 		}
 	}
 
@@ -101,11 +127,11 @@ func (uc *UseCase) Execute(ctx context.Context, input *Input) error {
 	checkIns, err := uc.checkInRepo.FindByActivityIDs(ctx, activityIDs)
 
 	if err != nil {
-		return fmt.Errorf("failed to find check-ins by activity IDs: %w", err)
+		return nil, fmt.Errorf("failed to find check-ins by activity IDs: %w", err)
 	}
 
 	if len(checkIns) == 0 {
-		return errors.New("no check-ins found for activities")
+		return nil, errors.New("no check-ins found for activities")
 	}
 
 	// faz array com userIDs de checkIns, removendo duplicados
@@ -122,11 +148,11 @@ func (uc *UseCase) Execute(ctx context.Context, input *Input) error {
 
 	users, err := uc.userAuthSvc.GetUserInfoBatch(ctx, userIDs)
 	if err != nil {
-		return fmt.Errorf("failed to get user info batch: %w", err)
+		return nil, fmt.Errorf("failed to get user info batch: %w", err)
 	}
 
 	if len(users) == 0 {
-		return errors.New("no users found for check-ins")
+		return nil, errors.New("no users found for check-ins")
 	}
 
 	// indexar users por userID
@@ -141,63 +167,169 @@ func (uc *UseCase) Execute(ctx context.Context, input *Input) error {
 		activityIndex[activity.ID] = activity
 	}
 
-	// agrupar checkIns com informacoes de users
-	jobs := make([]*queue.CertificateJob, 0, len(checkIns))
+	eventInfo := queue.EventInfo{
+		EventID:   event.ID,
+		EventName: event.Name,
+		StartDate: event.StartDate,
+		EndDate:   event.EndDate,
+	}
+
+	// agrupar checkIns por usuario, mantendo a ordem de chegada
+	checkInsByUser := make(map[string][]*entity.CheckIn, len(userIDs))
 	for _, checkIn := range checkIns {
+		checkInsByUser[checkIn.UserID] = append(checkInsByUser[checkIn.UserID], checkIn)
+	}
+
+	jobs := make([]*queue.CertificateJob, 0, len(checkIns)+len(userIDs))
+	notQualified := make([]NotQualifiedUser, 0)
+	qualifiedUsers := 0
 
-		user, ok := userIndex[checkIn.UserID]
+	for _, userID := range userIDs {
+		user, ok := userIndex[userID]
 		if !ok {
-			return fmt.Errorf("user not found: %s", checkIn.UserID)
+			return nil, fmt.Errorf("user not found: %s", userID)
 		}
 
-		activity, ok := activityIndex[checkIn.ActivityID]
-		if !ok {
-			return fmt.Errorf("activity not found: %s", checkIn.ActivityID)
-		}
-
-		job, err := queue.NewCertificateJob(queue.NewCertificateJobParams{
-			EventInfo: queue.EventInfo{
-				EventID:   event.ID,
-				EventName: event.Name,
-			},
-			UserInfo: queue.UserInfo{
-				UserID:    user.ID,
-				UserName:  user.FirstName + " " + user.LastName,
-				UserEmail: user.Email,
-			},
-			ActivityInfo: queue.ActivityInfo{
-				ActivityID:   activity.ID,
-				ActivityName: activity.Name,
-				ActivityDate: activity.StartDate,
-				StartTime:    activity.StartDate,
-				EndTime:      activity.EndDate,
-			},
-			CheckedAt: checkIn.CheckedAt,
-		})
-		if err != nil {
-			return fmt.Errorf("failed to create certificate job: %w", err)
+		userInfo := queue.UserInfo{
+			UserID:    user.ID,
+			UserName:  user.FirstName + " " + user.LastName,
+			UserEmail: user.Email,
 		}
 
-		jobs = append(jobs, job)
-	}
+		userCheckIns := checkInsByUser[userID]
+		attended := make([]queue.ActivityInfo, 0, len(userCheckIns))
+		var (
+			lastCheckedAt time.Time
+			workload      time.Duration
+		)
+
+		for _, checkIn := range userCheckIns {
+			activity, ok := activityIndex[checkIn.ActivityID]
+			if !ok {
+				return nil, fmt.Errorf("activity not found: %s", checkIn.ActivityID)
+			}
+
+			activityWorkload := event.CheckInWorkload(activity, checkIn)
+			workload += activityWorkload
+
+			activityInfo := queue.ActivityInfo{
+				ActivityID:      activity.ID,
+				ActivityName:    activity.Name,
+				ActivityDate:    activity.StartDate,
+				StartTime:       activity.StartDate,
+				EndTime:         activity.EndDate,
+				WorkloadMinutes: nil,
+			}
+			// certificados com o tempo efetivamente assistido
+			if event.WorkloadSource == entity.WorkloadSourceAttended {
+				minutes := int(activityWorkload.Minutes())
+				activityInfo.WorkloadMinutes = &minutes
+			}
+			attended = append(attended, activityInfo)
+
+			if checkIn.CheckedAt.After(lastCheckedAt) {
+				lastCheckedAt = checkIn.CheckedAt
+			}
+		}
 
-	// enfileirar jobs
-	if err := uc.certificateQueue.EnqueueBatch(ctx, jobs); err != nil {
-		return fmt.Errorf("failed to enqueue certificate jobs: %w", err)
+		// participantes abaixo da frequência mínima não recebem certificado
+		if !event.MeetsAttendanceRule(len(attended), len(activities), workload) {
+			notQualified = append(notQualified, NotQualifiedUser{
+				UserID:             user.ID,
+				Name:               userInfo.UserName,
+				Email:              user.Email,
+				AttendedActivities: len(attended),
+				AttendedMinutes:    int(workload.Minutes()),
+			})
+			continue
+		}
+		qualifiedUsers++
+
+		// um certificado por atividade
+		if event.IssuesPerActivityCertificates() {
+			for i, checkIn := range userCheckIns {
+				job, err := queue.NewCertificateJob(queue.NewCertificateJobParams{
+					EventInfo:    eventInfo,
+					UserInfo:     userInfo,
+					ActivityInfo: attended[i],
+					CheckedAt:    checkIn.CheckedAt,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to create certificate job: %w", err)
+				}
+
+				jobs = append(jobs, job)
+			}
+		}
+
+		// um certificado consolidado por participante, com todas as atividades
+		if event.IssuesConsolidatedCertificate() {
+			sort.Slice(attended, func(i, j int) bool {
+				return attended[i].StartTime.Before(attended[j].StartTime)
+			})
+
+			job, err := queue.NewEventCertificateJob(queue.NewEventCertificateJobParams{
+				EventInfo:  eventInfo,
+				UserInfo:   userInfo,
+				Activities: attended,
+				CheckedAt:  lastCheckedAt,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create certificate job: %w", err)
+			}
+
+			jobs = append(jobs, job)
+		}
 	}
 
-	// atualizar status do evento para completed em transaction
-	completedStatus := entity.EventStatusCompleted
+	// atualizar status do evento para completed e gravar os jobs no outbox na mesma transaction;
+	// com a fila no banco os jobs já entram na fila nessa transaction, senão o relay do worker
+	// os publica depois do commit
+	added := 0
 	err = uc.txProvider.Transact(ctx, func(repos repository.Repositories) error {
-		//nolint:exhaustruct
-		_, err := repos.Events.PartialUpdate(ctx, input.EventID, repository.UpdateEventInput{
-			Status: &completedStatus,
-		})
-		return err
+		if !alreadyCompleted {
+			//nolint:exhaustruct
+			if _, err := repos.Events.PartialUpdate(ctx, input.EventID, repository.UpdateEventInput{
+				Status: &event.Status,
+			}); err != nil {
+				return fmt.Errorf("failed to update event status: %w", err)
+			}
+		}
+
+		// jobs já gravados por uma finalização anterior são ignorados pela DedupKey
+		addedJobs, err := repos.CertificateJobs.Add(ctx, event.ID, jobs)
+		if err != nil {
+			return fmt.Errorf("failed to store certificate jobs: %w", err)
+		}
+		added = len(addedJobs)
+
+		if repos.CertificateQueue == nil || len(addedJobs) == 0 {
+			return nil
+		}
+
+		if err := repos.CertificateQueue.EnqueueBatch(ctx, addedJobs); err != nil {
+			return fmt.Errorf("failed to enqueue certificate jobs: %w", err)
+		}
+
+		jobIDs := make([]string, len(addedJobs))
+		for i, job := range addedJobs {
+			jobIDs[i] = job.GetJobID()
+		}
+
+		if err := repos.CertificateJobs.MarkDispatched(ctx, jobIDs, time.Now()); err != nil {
+			return fmt.Errorf("failed to mark certificate jobs as dispatched: %w", err)
+		}
+
+		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to update event status: %w", err)
+		return nil, err
 	}
 
-	return nil
+	return &Output{
+		EnqueuedJobs:         added,
+		ExistingCertificates: len(jobs) - added,
+		QualifiedUsers:       qualifiedUsers,
+		NotQualified:         notQualified,
+	}, nil
 }
@@ -0,0 +1,67 @@
+package getcertificatetemplate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+type Input struct {
+	UserID  string
+	EventID string
+}
+
+type Output struct {
+	// Template é o template padrão (IsDefault) quando o evento não tem um configurado
+	Template *entity.CertificateTemplate
+}
+
+type UseCase struct {
+	templateRepo repository.CertificateTemplateRepository
+	eventRepo    repository.EventRepository
+	userAuthSvc  service.UserAuthorizationService
+}
+
+func NewUseCase(
+	templateRepo repository.CertificateTemplateRepository,
+	eventRepo repository.EventRepository,
+	userAuthSvc service.UserAuthorizationService,
+) *UseCase {
+	return &UseCase{
+		templateRepo: templateRepo,
+		eventRepo:    eventRepo,
+		userAuthSvc:  userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	template, err := uc.templateRepo.FindByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find certificate template: %w", err)
+	}
+
+	if template == nil {
+		template = entity.DefaultCertificateTemplate(event.ID)
+	}
+
+	return &Output{Template: template}, nil
+}
@@ -0,0 +1,100 @@
+package getcheckintoken
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+type Input struct {
+	UserID     string
+	ActivityID string
+}
+
+type Output struct {
+	ActivityID    string
+	Token         string
+	ExpiresAt     time.Time
+	PeriodSeconds int
+	// CheckInURL é o conteúdo do QR code exibido no projetor: o link do app de check-in
+	// com activity_id e token ou, sem appURL configurada, apenas o token
+	CheckInURL string
+}
+
+type UseCase struct {
+	activityRepo repository.ActivityRepository
+	tokenSvc     service.CheckInTokenService
+	userAuthSvc  service.UserAuthorizationService
+	// página do frontend (ou deep link) que lê o QR code e faz o check-in autenticado
+	appURL string
+}
+
+func NewUseCase(activityRepo repository.ActivityRepository, tokenSvc service.CheckInTokenService, userAuthSvc service.UserAuthorizationService, appURL string) *UseCase {
+	return &UseCase{
+		activityRepo: activityRepo,
+		tokenSvc:     tokenSvc,
+		userAuthSvc:  userAuthSvc,
+		appURL:       appURL,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	activity, err := uc.activityRepo.FindByID(ctx, input.ActivityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find activity: %w", err)
+	}
+	if activity == nil {
+		return nil, fmt.Errorf("activity not found")
+	}
+
+	if !activity.CheckInTokenRequired {
+		return nil, fmt.Errorf("check-in token not enabled for activity")
+	}
+
+	token := uc.tokenSvc.Generate(activity.ID, activity.CheckInTokenPeriod(), time.Now())
+
+	checkInURL, err := uc.checkInURL(activity.ID, token.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build check-in URL: %w", err)
+	}
+
+	return &Output{
+		ActivityID:    activity.ID,
+		Token:         token.Value,
+		ExpiresAt:     token.ExpiresAt,
+		PeriodSeconds: activity.CheckInTokenPeriodSeconds,
+		CheckInURL:    checkInURL,
+	}, nil
+}
+
+// checkInURL monta o link do app com activity_id e token na query, mantendo os parâmetros já existentes;
+// a rota POST da API não serve como link, já que exige autenticação e o body do check-in
+func (uc *UseCase) checkInURL(activityID, token string) (string, error) {
+	if uc.appURL == "" {
+		return token, nil
+	}
+
+	u, err := url.Parse(uc.appURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("activity_id", activityID)
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
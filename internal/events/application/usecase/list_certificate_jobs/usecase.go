@@ -0,0 +1,99 @@
+package listcertificatejobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+	"golang.org/x/sync/errgroup"
+)
+
+type Input struct {
+	EventID string
+	UserID  string
+	// Status filtra a lista de jobs; vazio retorna todos
+	Status string
+}
+
+type Output struct {
+	// Counts considera todos os jobs do evento, independente do filtro
+	Counts map[repository.CertificateJobStatus]int
+	Jobs   []*repository.CertificateJobRecord
+}
+
+type UseCase struct {
+	jobRepo     repository.CertificateJobRepository
+	eventRepo   repository.EventRepository
+	userAuthSvc service.UserAuthorizationService
+}
+
+func NewUseCase(jobRepo repository.CertificateJobRepository, eventRepo repository.EventRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		jobRepo:     jobRepo,
+		eventRepo:   eventRepo,
+		userAuthSvc: userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, errors.New("user is not an admin")
+	}
+
+	var status *repository.CertificateJobStatus
+	if input.Status != "" {
+		s := repository.CertificateJobStatus(input.Status)
+		if !s.IsValid() {
+			return nil, errors.New("invalid status")
+		}
+		status = &s
+	}
+
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, errors.New("event not found")
+	}
+
+	var (
+		counts map[repository.CertificateJobStatus]int
+		jobs   []*repository.CertificateJobRecord
+	)
+
+	g, gCtx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		var err error
+		counts, err = uc.jobRepo.CountByStatus(gCtx, event.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count certificate jobs: %w", err)
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		var err error
+		jobs, err = uc.jobRepo.FindByEventID(gCtx, event.ID, status)
+		if err != nil {
+			return fmt.Errorf("failed to find certificate jobs: %w", err)
+		}
+		return nil
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return &Output{
+		Counts: counts,
+		Jobs:   jobs,
+	}, nil
+}
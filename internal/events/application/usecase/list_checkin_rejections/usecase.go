@@ -0,0 +1,58 @@
+package listcheckinrejections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+type Input struct {
+	UserID  string
+	EventID string
+}
+
+type Output struct {
+	Rejections []*entity.CheckInRejection
+}
+
+type UseCase struct {
+	rejectionRepo repository.CheckInRejectionRepository
+	eventRepo     repository.EventRepository
+	userAuthSvc   service.UserAuthorizationService
+}
+
+func NewUseCase(rejectionRepo repository.CheckInRejectionRepository, eventRepo repository.EventRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		rejectionRepo: rejectionRepo,
+		eventRepo:     eventRepo,
+		userAuthSvc:   userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	rejections, err := uc.rejectionRepo.FindByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list check-in rejections: %w", err)
+	}
+
+	return &Output{Rejections: rejections}, nil
+}
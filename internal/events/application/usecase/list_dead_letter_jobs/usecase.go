@@ -0,0 +1,60 @@
+package listdeadletterjobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 500
+)
+
+type Input struct {
+	UserID string
+	Limit  int64
+}
+
+type Output struct {
+	Jobs []*queue.CertificateJob
+}
+
+type UseCase struct {
+	certificateQueue queue.CertificateQueue
+	userAuthSvc      service.UserAuthorizationService
+}
+
+func NewUseCase(certificateQueue queue.CertificateQueue, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		certificateQueue: certificateQueue,
+		userAuthSvc:      userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	jobs, err := uc.certificateQueue.ListDeadLetters(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead letter jobs: %w", err)
+	}
+
+	return &Output{Jobs: jobs}, nil
+}
@@ -0,0 +1,115 @@
+package listevents
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+type Input struct {
+	UserID string
+	// filtros; Statuses é ignorado para quem não é admin
+	Statuses []entity.EventStatus
+	From     *time.Time
+	To       *time.Time
+	Search   string
+	// Cursor é o NextCursor da página anterior
+	Cursor string
+	Limit  int
+}
+
+type Output struct {
+	Events []*entity.Event
+	// vazio quando não há mais páginas
+	NextCursor string
+}
+
+type UseCase struct {
+	eventRepo   repository.EventRepository
+	userAuthSvc service.UserAuthorizationService
+}
+
+func NewUseCase(eventRepo repository.EventRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		eventRepo:   eventRepo,
+		userAuthSvc: userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	user, err := uc.userAuthSvc.GetUserByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	if input.From != nil && input.To != nil && input.To.Before(*input.From) {
+		return nil, fmt.Errorf("from must be before to")
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	//nolint:exhaustruct
+	filter := repository.EventListFilter{
+		Statuses: input.Statuses,
+		From:     input.From,
+		To:       input.To,
+		Search:   strings.TrimSpace(input.Search),
+		// uma linha a mais indica que existe próxima página
+		Limit: limit + 1,
+	}
+
+	// participantes só veem eventos publicados e abertos ao domínio do seu email
+	if !user.IsAdmin {
+		domain := entity.EmailDomain(user.Email)
+		filter.Statuses = []entity.EventStatus{entity.EventStatusPublished}
+		filter.EmailDomain = &domain
+	}
+
+	if input.Cursor != "" {
+		createdAt, id, err := lib.DecodeCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = &repository.EventCursor{
+			CreatedAt: createdAt,
+			ID:        id,
+		}
+	}
+
+	events, err := uc.eventRepo.FindPage(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	output := &Output{
+		Events:     events,
+		NextCursor: "",
+	}
+	if len(events) > limit {
+		output.Events = events[:limit]
+		last := events[limit-1]
+		output.NextCursor = lib.EncodeCursor(last.CreatedAt, last.ID)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,36 @@
+package listusercertificates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+)
+
+type Input struct {
+	UserID string
+}
+
+type Output struct {
+	Certificates []*entity.Certificate
+}
+
+type UseCase struct {
+	certificateRepo repository.CertificateRepository
+}
+
+func NewUseCase(certificateRepo repository.CertificateRepository) *UseCase {
+	return &UseCase{
+		certificateRepo: certificateRepo,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	certificates, err := uc.certificateRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user certificates: %w", err)
+	}
+
+	return &Output{Certificates: certificates}, nil
+}
@@ -4,16 +4,28 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
 	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
 )
 
 type Input struct {
 	UserID string
+	// opcional, restringe aos check-ins de um evento
+	EventID *string
+	// Cursor é o NextCursor da página anterior
+	Cursor string
+	Limit  int
 }
 
 type Output struct {
-	CheckIns []*entity.CheckIn
+	CheckIns []*repository.UserCheckIn
+	// vazio quando não há mais páginas
+	NextCursor string
 }
 
 type UseCase struct {
@@ -27,10 +39,46 @@ func NewUseCase(checkInRepo repository.CheckInRepository) *UseCase {
 }
 
 func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
-	checkIns, err := uc.checkInRepo.FindByUserID(ctx, input.UserID)
+	limit := input.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	filter := repository.UserCheckInListFilter{
+		EventID: input.EventID,
+		After:   nil,
+		// uma linha a mais indica que existe próxima página
+		Limit: limit + 1,
+	}
+
+	if input.Cursor != "" {
+		checkedAt, id, err := lib.DecodeCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = &repository.CheckInCursor{
+			CheckedAt: checkedAt,
+			ID:        id,
+		}
+	}
+
+	checkIns, err := uc.checkInRepo.FindPageByUserID(ctx, input.UserID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list user check-ins: %w", err)
 	}
 
-	return &Output{CheckIns: checkIns}, nil
+	output := &Output{
+		CheckIns:   checkIns,
+		NextCursor: "",
+	}
+	if len(checkIns) > limit {
+		last := checkIns[limit-1]
+		output.CheckIns = checkIns[:limit]
+		output.NextCursor = lib.EncodeCursor(last.CheckedAt, last.ID)
+	}
+
+	return output, nil
 }
@@ -0,0 +1,95 @@
+package previewcertificatetemplate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/infra/pdf"
+)
+
+// Dados fictícios usados na pré-visualização
+const (
+	sampleRecipientName    = "Nome do Participante"
+	sampleActivityName     = "Atividade de Exemplo"
+	sampleVerificationCode = "AMOSTRA234"
+	sampleWorkload         = 2 * time.Hour
+)
+
+type Input struct {
+	UserID  string
+	EventID string
+}
+
+type Output struct {
+	Content []byte
+}
+
+type UseCase struct {
+	eventRepo      repository.EventRepository
+	templateLoader *pdf.TemplateLoader
+	generator      pdf.CertificateGenerator
+	userAuthSvc    service.UserAuthorizationService
+	verifyBaseURL  string
+}
+
+func NewUseCase(
+	eventRepo repository.EventRepository,
+	templateLoader *pdf.TemplateLoader,
+	generator pdf.CertificateGenerator,
+	userAuthSvc service.UserAuthorizationService,
+	verifyBaseURL string,
+) *UseCase {
+	return &UseCase{
+		eventRepo:      eventRepo,
+		templateLoader: templateLoader,
+		generator:      generator,
+		userAuthSvc:    userAuthSvc,
+		verifyBaseURL:  verifyBaseURL,
+	}
+}
+
+// Execute gera um PDF de exemplo com o template atual do evento
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	template, err := uc.templateLoader.Load(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := uc.generator.Generate(ctx, pdf.CertificateData{
+		RecipientName:    sampleRecipientName,
+		EventName:        event.Name,
+		ActivityName:     sampleActivityName,
+		EventDate:        pdf.FormatDate(event.StartDate),
+		Workload:         entity.FormatWorkload(sampleWorkload),
+		CertificateDate:  pdf.FormatDate(time.Now()),
+		VerificationCode: sampleVerificationCode,
+		VerificationURL:  pdf.VerificationURL(uc.verifyBaseURL, sampleVerificationCode),
+		Activities:       nil,
+		Template:         template,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate certificate preview: %w", err)
+	}
+
+	return &Output{Content: content}, nil
+}
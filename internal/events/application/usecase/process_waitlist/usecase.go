@@ -0,0 +1,154 @@
+package processwaitlist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+// DefaultConfirmationWindow é o prazo padrão para confirmar uma vaga vinda da lista de espera
+const DefaultConfirmationWindow = 24 * time.Hour
+
+type Input struct {
+	ActivityID string
+}
+
+type Output struct {
+	// vagas oferecidas que venceram sem confirmação
+	Expired int64
+	// inscrições que saíram da lista de espera
+	Promoted            []*entity.Registration
+	NotifiedAttendees   int
+	FailedNotifications int
+}
+
+type UseCase struct {
+	txProvider         repository.TransactionProvider
+	userAuthSvc        service.UserAuthorizationService
+	notifier           service.AttendeeNotifier
+	confirmationWindow time.Duration
+}
+
+func NewUseCase(
+	txProvider repository.TransactionProvider,
+	userAuthSvc service.UserAuthorizationService,
+	notifier service.AttendeeNotifier,
+	confirmationWindow time.Duration,
+) *UseCase {
+	if confirmationWindow <= 0 {
+		confirmationWindow = DefaultConfirmationWindow
+	}
+
+	return &UseCase{
+		txProvider:         txProvider,
+		userAuthSvc:        userAuthSvc,
+		notifier:           notifier,
+		confirmationWindow: confirmationWindow,
+	}
+}
+
+// Execute expira as vagas não confirmadas e oferece as vagas livres aos próximos da lista de espera
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	now := time.Now()
+	deadline := now.Add(uc.confirmationWindow)
+
+	output := &Output{
+		Expired:             0,
+		Promoted:            nil,
+		NotifiedAttendees:   0,
+		FailedNotifications: 0,
+	}
+
+	// 1. Com a atividade bloqueada, expirar e promover na mesma transaction
+	var (
+		activity *entity.Activity
+		event    *entity.Event
+	)
+	err := uc.txProvider.Transact(ctx, func(repos repository.Repositories) error {
+		var err error
+		activity, err = repos.Activities.FindByIDForUpdate(ctx, input.ActivityID)
+		if err != nil {
+			return fmt.Errorf("failed to find activity: %w", err)
+		}
+		if activity == nil {
+			return fmt.Errorf("activity not found")
+		}
+
+		output.Expired, err = repos.Registrations.ExpireOffers(ctx, activity.ID, now)
+		if err != nil {
+			return fmt.Errorf("failed to expire waitlist offers: %w", err)
+		}
+
+		event, err = repos.Events.FindByID(ctx, activity.EventID)
+		if err != nil {
+			return fmt.Errorf("failed to find event: %w", err)
+		}
+		// eventos encerrados, cancelados ou em rascunho não oferecem vagas
+		if event == nil || !event.AcceptsRegistrations(now) {
+			return nil
+		}
+
+		seats, err := repos.Registrations.CountSeatsByActivityID(ctx, activity.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count activity registrations: %w", err)
+		}
+
+		// sem capacidade, toda a lista de espera é atendida
+		free, err := repos.Registrations.CountWaitlistedByActivityID(ctx, activity.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count waitlisted registrations: %w", err)
+		}
+		if activity.HasCapacity() {
+			free = min(free, *activity.Capacity-seats)
+		}
+		if free <= 0 {
+			return nil
+		}
+
+		waitlisted, err := repos.Registrations.FindWaitlisted(ctx, activity.ID, free)
+		if err != nil {
+			return fmt.Errorf("failed to find waitlisted registrations: %w", err)
+		}
+
+		for _, registration := range waitlisted {
+			if err := registration.Offer(deadline); err != nil {
+				return err
+			}
+			promoted, err := repos.Registrations.UpdateStatus(ctx, registration)
+			if err != nil {
+				return fmt.Errorf("failed to promote registration: %w", err)
+			}
+			output.Promoted = append(output.Promoted, promoted)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.Promoted) == 0 {
+		return output, nil
+	}
+
+	// 2. Avisar os promovidos; as vagas já estão reservadas, falhas de envio são apenas contabilizadas
+	userIDs := make([]string, len(output.Promoted))
+	for i, registration := range output.Promoted {
+		userIDs[i] = registration.UserID
+	}
+
+	users, err := uc.userAuthSvc.GetUserInfoBatch(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	sent, _ := uc.notifier.NotifyWaitlistPromotion(ctx, event, activity, deadline, users)
+	output.NotifiedAttendees = sent
+	output.FailedNotifications = len(output.Promoted) - sent
+
+	return output, nil
+}
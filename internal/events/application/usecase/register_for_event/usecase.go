@@ -0,0 +1,171 @@
+package registerforevent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+type Input struct {
+	UserID  string
+	EventID string
+	// atividades em que o participante também quer se inscrever; vazio inscreve apenas no evento
+	ActivityIDs []string
+}
+
+type Output struct {
+	// inscrições criadas nesta requisição; nas atividades lotadas, com status waitlisted
+	Registrations []*entity.Registration
+}
+
+type UseCase struct {
+	txProvider  repository.TransactionProvider
+	eventRepo   repository.EventRepository
+	userAuthSvc service.UserAuthorizationService
+}
+
+func NewUseCase(
+	txProvider repository.TransactionProvider,
+	eventRepo repository.EventRepository,
+	userAuthSvc service.UserAuthorizationService,
+) *UseCase {
+	return &UseCase{
+		txProvider:  txProvider,
+		eventRepo:   eventRepo,
+		userAuthSvc: userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// 1. Buscar usuário e evento
+	user, err := uc.userAuthSvc.GetUserByID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	// 2. Mesmas regras de acesso do check-in
+	if !event.AcceptsRegistrations(time.Now()) {
+		return nil, entity.ErrRegistrationClosed
+	}
+	if !event.IsAllowedDomain(user.Email) {
+		return nil, fmt.Errorf("user domain not allowed")
+	}
+
+	activityIDs := slices.Compact(slices.Sorted(slices.Values(input.ActivityIDs)))
+
+	// 3. Inscrever em transaction; a atividade fica bloqueada enquanto as vagas são contadas
+	output := &Output{Registrations: nil}
+	err = uc.txProvider.Transact(ctx, func(repos repository.Repositories) error {
+		existing, err := repos.Registrations.FindByUserAndEvent(ctx, user.ID, event.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find registrations: %w", err)
+		}
+
+		// a inscrição no evento é criada junto com a primeira inscrição em atividade
+		registeredInEvent := slices.ContainsFunc(existing, func(r *entity.Registration) bool {
+			return !r.IsForActivity()
+		})
+		if registeredInEvent && len(activityIDs) == 0 {
+			return entity.ErrAlreadyRegistered
+		}
+		if !registeredInEvent {
+			registration, err := uc.save(ctx, repos, user.ID, event.ID, nil, false)
+			if err != nil {
+				return err
+			}
+			output.Registrations = append(output.Registrations, registration)
+		}
+
+		for _, activityID := range activityIDs {
+			registration, err := uc.registerForActivity(ctx, repos, user.ID, event.ID, activityID, existing)
+			if err != nil {
+				return err
+			}
+			output.Registrations = append(output.Registrations, registration)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (uc *UseCase) registerForActivity(
+	ctx context.Context,
+	repos repository.Repositories,
+	userID, eventID, activityID string,
+	existing []*entity.Registration,
+) (*entity.Registration, error) {
+	activity, err := repos.Activities.FindByIDForUpdate(ctx, activityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find activity: %w", err)
+	}
+	if activity == nil || activity.EventID != eventID {
+		return nil, fmt.Errorf("activity not found")
+	}
+
+	for _, r := range existing {
+		if r.ActivityID != nil && *r.ActivityID == activityID {
+			return nil, fmt.Errorf("%w: %s", entity.ErrAlreadyRegistered, activity.Name)
+		}
+	}
+
+	// atividade lotada: entra na lista de espera
+	waitlisted := false
+	if activity.HasCapacity() {
+		seats, err := repos.Registrations.CountSeatsByActivityID(ctx, activityID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count activity registrations: %w", err)
+		}
+		waiting, err := repos.Registrations.CountWaitlistedByActivityID(ctx, activityID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count waitlisted registrations: %w", err)
+		}
+		// quem já está na fila tem prioridade sobre as vagas que acabaram de abrir
+		waitlisted = activity.IsFull(seats) || waiting > 0
+	}
+
+	return uc.save(ctx, repos, userID, eventID, &activity.ID, waitlisted)
+}
+
+func (uc *UseCase) save(ctx context.Context, repos repository.Repositories, userID, eventID string, activityID *string, waitlisted bool) (*entity.Registration, error) {
+	registration, err := entity.NewRegistration(entity.NewRegistrationParams{
+		UserID:     userID,
+		EventID:    eventID,
+		ActivityID: activityID,
+		Waitlisted: waitlisted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := repos.Registrations.Save(ctx, registration)
+	if err != nil {
+		if errors.Is(err, entity.ErrAlreadyRegistered) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to save registration: %w", err)
+	}
+
+	return saved, nil
+}
@@ -0,0 +1,100 @@
+package registermanualcheckin
+
+import (
+	"context"
+	"fmt"
+
+	checkinactivity "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/checkin_activity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+)
+
+// Input identifica o participante por exatamente um entre AttendeeID, Email ou CPF
+type Input struct {
+	AdminID            string
+	ActivityID         string
+	AttendeeID         string
+	Email              string
+	CPF                string
+	Reason             string
+	OverrideTimeWindow bool
+}
+
+type Output struct {
+	CheckIn *entity.CheckIn
+}
+
+type UseCase struct {
+	checkIn     *checkinactivity.UseCase
+	userAuthSvc service.UserAuthorizationService
+}
+
+func NewUseCase(checkIn *checkinactivity.UseCase, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		checkIn:     checkIn,
+		userAuthSvc: userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// 1. Verificar se é admin
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.AdminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	// 2. Encontrar o participante
+	attendee, err := uc.findAttendee(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	if attendee == nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	// 3. Registrar pelo mesmo fluxo do check-in do participante
+	output, err := uc.checkIn.Execute(ctx, &checkinactivity.Input{
+		UserID:             attendee.ID,
+		ActivityID:         input.ActivityID,
+		Token:              "",
+		Latitude:           nil,
+		Longitude:          nil,
+		RegisteredBy:       input.AdminID,
+		Reason:             input.Reason,
+		OverrideTimeWindow: input.OverrideTimeWindow,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Output{CheckIn: output.CheckIn}, nil
+}
+
+func (uc *UseCase) findAttendee(ctx context.Context, input *Input) (*service.UserInfo, error) {
+	identifiers := 0
+	for _, v := range []string{input.AttendeeID, input.Email, input.CPF} {
+		if v != "" {
+			identifiers++
+		}
+	}
+	if identifiers != 1 {
+		return nil, fmt.Errorf("exactly one of user_id, email or cpf is required")
+	}
+
+	switch {
+	case input.AttendeeID != "":
+		return uc.userAuthSvc.GetUserByID(ctx, input.AttendeeID)
+	case input.Email != "":
+		return uc.userAuthSvc.FindUserByEmail(ctx, input.Email)
+	default:
+		result := lib.ValidateCPF(input.CPF)
+		if !result.Valid {
+			return nil, fmt.Errorf("invalid cpf")
+		}
+		return uc.userAuthSvc.FindUserByCPF(ctx, *result.CleanedCPF)
+	}
+}
@@ -0,0 +1,63 @@
+package reopenevent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+type Input struct {
+	UserID  string
+	EventID string
+}
+
+type Output struct {
+	Event *entity.Event
+}
+
+type UseCase struct {
+	eventRepo   repository.EventRepository
+	userAuthSvc service.UserAuthorizationService
+}
+
+func NewUseCase(eventRepo repository.EventRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		eventRepo:   eventRepo,
+		userAuthSvc: userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	if err := event.Reopen(); err != nil {
+		return nil, err
+	}
+
+	//nolint:exhaustruct
+	updated, err := uc.eventRepo.PartialUpdate(ctx, event.ID, repository.UpdateEventInput{
+		Status: &event.Status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update event status: %w", err)
+	}
+
+	return &Output{Event: updated}, nil
+}
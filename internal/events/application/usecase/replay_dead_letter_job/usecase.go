@@ -0,0 +1,87 @@
+package replaydeadletterjob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+// status que um job na dead-letter pode ter: failed pelo Nack ou a última etapa registrada
+// quando a reserva expirou vezes demais
+var deadLetteredStatuses = []repository.CertificateJobStatus{
+	repository.CertificateJobStatusFailed,
+	repository.CertificateJobStatusRetrying,
+	repository.CertificateJobStatusGenerating,
+	repository.CertificateJobStatusSending,
+}
+
+type Input struct {
+	UserID string
+	JobID  string
+}
+
+type Output struct {
+	Job *queue.CertificateJob
+}
+
+type UseCase struct {
+	certificateQueue queue.CertificateQueue
+	jobRepo          repository.CertificateJobRepository
+	userAuthSvc      service.UserAuthorizationService
+}
+
+func NewUseCase(certificateQueue queue.CertificateQueue, jobRepo repository.CertificateJobRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		certificateQueue: certificateQueue,
+		jobRepo:          jobRepo,
+		userAuthSvc:      userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	// o job volta para queued antes de sair da dead-letter: um reenvio concorrente passa a vê-lo
+	// em andamento e não publica uma segunda cópia
+	record, err := uc.jobRepo.FindByID(ctx, input.JobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find certificate job: %w", err)
+	}
+
+	// jobs sem registro (publicados antes do outbox) só existem na fila
+	if record != nil {
+		moved, err := uc.jobRepo.TransitionStatus(ctx, input.JobID, repository.CertificateJobStatusQueued, deadLetteredStatuses...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update certificate job status: %w", err)
+		}
+		// já reenviado ou em andamento, não está mais na dead-letter
+		if !moved {
+			return nil, queue.ErrJobNotFound
+		}
+	}
+
+	job, err := uc.certificateQueue.ReplayDeadLetter(ctx, input.JobID)
+	if err != nil {
+		if record != nil {
+			if _, rErr := uc.jobRepo.TransitionStatus(ctx, input.JobID, record.Status, repository.CertificateJobStatusQueued); rErr != nil {
+				return nil, fmt.Errorf("failed to replay dead letter job: %v, status rollback error: %w", err, rErr)
+			}
+		}
+		if errors.Is(err, queue.ErrJobNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to replay dead letter job: %w", err)
+	}
+
+	return &Output{Job: job}, nil
+}
@@ -0,0 +1,106 @@
+package resendcertificatejob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+var (
+	// ErrJobInProgress indica que a fila ainda tem uma cópia ativa do job (na fila, em processamento ou aguardando retry)
+	ErrJobInProgress = errors.New("certificate job is still in progress")
+	// ErrCertificateRevoked indica que o certificado ou o check-in do job foi revogado
+	ErrCertificateRevoked = errors.New("certificate has been revoked")
+)
+
+type Input struct {
+	EventID string
+	JobID   string
+	UserID  string
+}
+
+type Output struct {
+	Job *repository.CertificateJobRecord
+}
+
+type UseCase struct {
+	jobRepo          repository.CertificateJobRepository
+	certificateRepo  repository.CertificateRepository
+	certificateQueue queue.CertificateQueue
+	userAuthSvc      service.UserAuthorizationService
+}
+
+func NewUseCase(jobRepo repository.CertificateJobRepository, certificateRepo repository.CertificateRepository, certificateQueue queue.CertificateQueue, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		jobRepo:          jobRepo,
+		certificateRepo:  certificateRepo,
+		certificateQueue: certificateQueue,
+		userAuthSvc:      userAuthSvc,
+	}
+}
+
+// Execute volta o job para a fila; o certificado já emitido é reaproveitado e o email é enviado de novo
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, errors.New("user is not an admin")
+	}
+
+	job, err := uc.jobRepo.FindByID(ctx, input.JobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find certificate job: %w", err)
+	}
+	if job == nil || job.EventID != input.EventID {
+		return nil, queue.ErrJobNotFound
+	}
+
+	// certificados revogados não são enviados de novo
+	if job.Status == repository.CertificateJobStatusRevoked {
+		return nil, ErrCertificateRevoked
+	}
+	certificate, err := uc.certificateRepo.FindByJobID(ctx, job.Job.GetJobID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find certificate: %w", err)
+	}
+	if certificate != nil && !certificate.IsValid() {
+		return nil, ErrCertificateRevoked
+	}
+
+	// só jobs enviados ou na dead-letter; os demais ainda têm uma cópia ativa na fila e seriam duplicados
+	if !job.Status.IsTerminal() {
+		return nil, ErrJobInProgress
+	}
+
+	// o job reenviado não pode continuar na dead-letter, senão um replay o processaria de novo
+	if job.Status == repository.CertificateJobStatusFailed {
+		if err := uc.certificateQueue.RemoveDeadLetters(ctx, []string{job.Job.GetJobID()}); err != nil {
+			return nil, fmt.Errorf("failed to remove job from dead letter: %w", err)
+		}
+	}
+
+	requeued, err := uc.jobRepo.Requeue(ctx, []string{job.Job.GetJobID()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue certificate job: %w", err)
+	}
+	// o status mudou depois da leitura (ex: replay da dead-letter ou o worker pegou o job)
+	if requeued == 0 {
+		return nil, ErrJobInProgress
+	}
+
+	job, err = uc.jobRepo.FindByID(ctx, input.JobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find certificate job: %w", err)
+	}
+	if job == nil {
+		return nil, queue.ErrJobNotFound
+	}
+
+	return &Output{Job: job}, nil
+}
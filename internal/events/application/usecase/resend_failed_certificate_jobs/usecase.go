@@ -0,0 +1,88 @@
+package resendfailedcertificatejobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+type Input struct {
+	EventID string
+	UserID  string
+}
+
+type Output struct {
+	RequeuedJobs int64
+}
+
+type UseCase struct {
+	jobRepo          repository.CertificateJobRepository
+	certificateRepo  repository.CertificateRepository
+	eventRepo        repository.EventRepository
+	certificateQueue queue.CertificateQueue
+	userAuthSvc      service.UserAuthorizationService
+}
+
+func NewUseCase(jobRepo repository.CertificateJobRepository, certificateRepo repository.CertificateRepository, eventRepo repository.EventRepository, certificateQueue queue.CertificateQueue, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		jobRepo:          jobRepo,
+		certificateRepo:  certificateRepo,
+		eventRepo:        eventRepo,
+		certificateQueue: certificateQueue,
+		userAuthSvc:      userAuthSvc,
+	}
+}
+
+// Execute volta para a fila todos os jobs do evento que esgotaram as tentativas (dead-letter);
+// jobs aguardando retry continuam com a fila e jobs com o certificado revogado ficam na dead-letter
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, errors.New("user is not an admin")
+	}
+
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, errors.New("event not found")
+	}
+
+	failed := repository.CertificateJobStatusFailed
+	jobs, err := uc.jobRepo.FindByEventID(ctx, event.ID, &failed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find failed certificate jobs: %w", err)
+	}
+
+	jobIDs := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		certificate, err := uc.certificateRepo.FindByJobID(ctx, job.Job.GetJobID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find certificate: %w", err)
+		}
+		if certificate != nil && !certificate.IsValid() {
+			continue
+		}
+		jobIDs = append(jobIDs, job.Job.GetJobID())
+	}
+
+	// os jobs reenviados não podem continuar na dead-letter, senão um replay os processaria de novo
+	if err := uc.certificateQueue.RemoveDeadLetters(ctx, jobIDs); err != nil {
+		return nil, fmt.Errorf("failed to remove jobs from dead letter: %w", err)
+	}
+
+	requeued, err := uc.jobRepo.Requeue(ctx, jobIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue failed certificate jobs: %w", err)
+	}
+
+	return &Output{RequeuedJobs: requeued}, nil
+}
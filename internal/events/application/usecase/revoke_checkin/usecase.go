@@ -0,0 +1,137 @@
+package revokecheckin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+type Input struct {
+	UserID     string
+	ActivityID string
+	CheckInID  string
+	Reason     string
+}
+
+type Output struct {
+	CheckIn *entity.CheckIn
+	// certificados do participante revogados junto com o check-in: o da atividade e, se o participante
+	// deixou de atingir a frequência mínima, o consolidado do evento
+	RevokedCertificates int
+}
+
+type UseCase struct {
+	txProvider   repository.TransactionProvider
+	checkInRepo  repository.CheckInRepository
+	activityRepo repository.ActivityRepository
+	userAuthSvc  service.UserAuthorizationService
+}
+
+func NewUseCase(txProvider repository.TransactionProvider, checkInRepo repository.CheckInRepository, activityRepo repository.ActivityRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		txProvider:   txProvider,
+		checkInRepo:  checkInRepo,
+		activityRepo: activityRepo,
+		userAuthSvc:  userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// 1. Verificar se é admin
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	// 2. Buscar check-in da atividade
+	checkIn, err := uc.checkInRepo.FindByID(ctx, input.CheckInID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find check-in: %w", err)
+	}
+	if checkIn == nil || checkIn.ActivityID != input.ActivityID {
+		return nil, fmt.Errorf("check-in not found")
+	}
+
+	activity, err := uc.activityRepo.FindByID(ctx, checkIn.ActivityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find activity: %w", err)
+	}
+	if activity == nil {
+		return nil, fmt.Errorf("check-in not found")
+	}
+
+	// 3. Revogar mantendo o registro
+	revokedAt := time.Now()
+	if err := checkIn.Revoke(input.UserID, input.Reason, revokedAt); err != nil {
+		return nil, err
+	}
+
+	// 4. Revogar na mesma transação os certificados já emitidos com esse check-in
+	output := &Output{CheckIn: nil, RevokedCertificates: 0}
+	err = uc.txProvider.Transact(ctx, func(repos repository.Repositories) error {
+		saved, err := repos.CheckIns.Revoke(ctx, checkIn)
+		if err != nil {
+			return err
+		}
+		output.CheckIn = saved
+
+		revoked, err := repos.Certificates.RevokeByUserAndActivity(ctx, checkIn.UserID, activity.ID, revokedAt, input.Reason)
+		if err != nil {
+			return fmt.Errorf("failed to revoke certificates: %w", err)
+		}
+		output.RevokedCertificates = int(revoked)
+
+		// o consolidado só é revogado se o participante não atinge mais a frequência mínima sem esse check-in
+		qualifies, err := uc.stillQualifies(ctx, repos, checkIn.UserID, activity.EventID)
+		if err != nil {
+			return err
+		}
+		if qualifies {
+			return nil
+		}
+
+		revoked, err = repos.Certificates.RevokeConsolidated(ctx, checkIn.UserID, activity.EventID, revokedAt, input.Reason)
+		if err != nil {
+			return fmt.Errorf("failed to revoke certificates: %w", err)
+		}
+		output.RevokedCertificates += int(revoked)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// stillQualifies verifica a frequência mínima do participante com os check-ins que continuam válidos
+func (uc *UseCase) stillQualifies(ctx context.Context, repos repository.Repositories, userID, eventID string) (bool, error) {
+	event, err := repos.Events.FindByID(ctx, eventID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return false, fmt.Errorf("event not found")
+	}
+
+	activities, err := repos.Activities.FindByEventID(ctx, eventID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find activities: %w", err)
+	}
+
+	// check-ins revogados, inclusive o desta transação, não são retornados
+	checkIns, err := repos.CheckIns.FindByUserID(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find check-ins: %w", err)
+	}
+
+	return event.QualifiesForCertificate(activities, checkIns), nil
+}
@@ -0,0 +1,141 @@
+package updateactivity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+// Input traz apenas os campos alterados; nil mantém o valor atual
+type Input struct {
+	UserID      string
+	ActivityID  string
+	Name        *string
+	Description *string
+	StartDate   *time.Time
+	EndDate     *time.Time
+	// string vazia remove a atividade da trilha
+	Track *string
+	// 0 remove o limite de inscritos
+	Capacity *int
+}
+
+type Output struct {
+	Activity *entity.Activity
+}
+
+type UseCase struct {
+	activityRepo repository.ActivityRepository
+	userAuthSvc  service.UserAuthorizationService
+}
+
+func NewUseCase(activityRepo repository.ActivityRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		activityRepo: activityRepo,
+		userAuthSvc:  userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// 1. Verificar se é admin
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	// 2. Buscar atividade com o evento
+	result, err := uc.activityRepo.FindByActivityIDWithEvent(ctx, input.ActivityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find activity: %w", err)
+	}
+	if result == nil {
+		return nil, fmt.Errorf("activity not found")
+	}
+	activity, event := result.Activity, result.Event
+
+	if !event.IsEditable() {
+		return nil, entity.ErrEventNotEditable
+	}
+
+	// 3. Mesclar os campos alterados com os atuais
+	params := entity.NewActivityParams{
+		Name:        activity.Name,
+		EventID:     activity.EventID,
+		Description: activity.Description,
+		StartDate:   activity.StartDate,
+		EndDate:     activity.EndDate,
+		Track:       activity.Track,
+		Capacity:    activity.Capacity,
+		Venue:       activity.Venue,
+	}
+	if input.Name != nil {
+		params.Name = *input.Name
+	}
+	if input.Description != nil {
+		params.Description = input.Description
+	}
+	if input.StartDate != nil {
+		params.StartDate = *input.StartDate
+	}
+	if input.EndDate != nil {
+		params.EndDate = *input.EndDate
+	}
+	if input.Track != nil {
+		params.Track = input.Track
+	}
+	if input.Capacity != nil {
+		params.Capacity = input.Capacity
+	}
+
+	// 4. O nome continua único dentro do evento
+	if params.Name != activity.Name {
+		existing, err := uc.activityRepo.FindByEventIDAndNames(ctx, activity.EventID, []string{params.Name})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find activities by event ID and names: %w", err)
+		}
+		if len(existing) > 0 {
+			return nil, fmt.Errorf("activity with the same name already exists for this event")
+		}
+	}
+
+	if err := activity.Update(params); err != nil {
+		return nil, err
+	}
+
+	// 5. Validar as datas
+	scheduleChanged := input.StartDate != nil || input.EndDate != nil
+	if !activity.IsStartDateBeforeEndDate() {
+		return nil, entity.ErrActivityInvalidDates
+	}
+
+	if scheduleChanged && !event.ContainsActivity(activity) {
+		return nil, entity.ErrActivityOutsideEvent
+	}
+
+	if scheduleChanged || input.Track != nil {
+		others, err := uc.activityRepo.FindByEventID(ctx, activity.EventID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find activities by event ID: %w", err)
+		}
+		for _, other := range others {
+			if activity.OverlapsInTrack(other) {
+				return nil, fmt.Errorf("%w: %s", entity.ErrActivityTrackOverlap, other.Name)
+			}
+		}
+	}
+
+	// 6. Persistir
+	updated, err := uc.activityRepo.Update(ctx, activity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update activity: %w", err)
+	}
+
+	return &Output{Activity: updated}, nil
+}
@@ -0,0 +1,68 @@
+package updateactivityvenue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+// Input define o local da atividade; Venue nil remove o local e a atividade passa a usar o do evento
+type Input struct {
+	UserID     string
+	ActivityID string
+	Venue      *entity.NewVenueParams
+}
+
+type Output struct {
+	Activity *entity.Activity
+}
+
+type UseCase struct {
+	activityRepo repository.ActivityRepository
+	userAuthSvc  service.UserAuthorizationService
+}
+
+func NewUseCase(activityRepo repository.ActivityRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		activityRepo: activityRepo,
+		userAuthSvc:  userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	var venue entity.Venue
+	if input.Venue != nil {
+		venue, err = entity.NewVenue(*input.Venue)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	activity, err := uc.activityRepo.FindByID(ctx, input.ActivityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find activity: %w", err)
+	}
+	if activity == nil {
+		return nil, fmt.Errorf("activity not found")
+	}
+
+	activity.Venue = venue
+
+	updated, err := uc.activityRepo.UpdateCheckInSettings(ctx, activity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update activity venue: %w", err)
+	}
+
+	return &Output{Activity: updated}, nil
+}
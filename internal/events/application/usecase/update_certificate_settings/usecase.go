@@ -0,0 +1,91 @@
+package updatecertificatesettings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+// Input contém as configurações de certificado do evento; campos nil não são alterados
+type Input struct {
+	UserID              string
+	EventID             string
+	CertificateMode     *entity.CertificateMode
+	AttendanceRule      *entity.AttendanceRule
+	AttendanceThreshold *float64
+	WorkloadSource      *entity.WorkloadSource
+}
+
+type Output struct {
+	Event *entity.Event
+}
+
+type UseCase struct {
+	eventRepo   repository.EventRepository
+	userAuthSvc service.UserAuthorizationService
+}
+
+func NewUseCase(eventRepo repository.EventRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		eventRepo:   eventRepo,
+		userAuthSvc: userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	if input.CertificateMode != nil && !input.CertificateMode.IsValid() {
+		return nil, fmt.Errorf("invalid certificate mode")
+	}
+
+	if input.WorkloadSource != nil && !input.WorkloadSource.IsValid() {
+		return nil, fmt.Errorf("invalid workload source")
+	}
+
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	// a regra e o limite são validados juntos, combinando com os valores atuais
+	rule := event.AttendanceRule
+	if input.AttendanceRule != nil {
+		rule = *input.AttendanceRule
+	}
+	threshold := event.AttendanceThreshold
+	if input.AttendanceThreshold != nil {
+		threshold = *input.AttendanceThreshold
+	} else if rule == entity.AttendanceRuleNone {
+		threshold = 0
+	}
+
+	if err := entity.ValidateAttendanceRule(rule, threshold); err != nil {
+		return nil, err
+	}
+
+	//nolint:exhaustruct
+	updated, err := uc.eventRepo.PartialUpdate(ctx, event.ID, repository.UpdateEventInput{
+		CertificateMode:     input.CertificateMode,
+		AttendanceRule:      &rule,
+		AttendanceThreshold: &threshold,
+		WorkloadSource:      input.WorkloadSource,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update certificate settings: %w", err)
+	}
+
+	return &Output{Event: updated}, nil
+}
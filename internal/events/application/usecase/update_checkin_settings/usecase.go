@@ -0,0 +1,82 @@
+package updatecheckinsettings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+// Input contém as configurações de check-in da atividade; campos nil não são alterados
+type Input struct {
+	UserID             string
+	ActivityID         string
+	TokenRequired      *bool
+	TokenPeriodSeconds *int
+	WindowEnabled      *bool
+	OpensBeforeMinutes *int
+	ClosesAfterMinutes *int
+}
+
+type Output struct {
+	Activity *entity.Activity
+}
+
+type UseCase struct {
+	activityRepo repository.ActivityRepository
+	userAuthSvc  service.UserAuthorizationService
+}
+
+func NewUseCase(activityRepo repository.ActivityRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		activityRepo: activityRepo,
+		userAuthSvc:  userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	activity, err := uc.activityRepo.FindByID(ctx, input.ActivityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find activity: %w", err)
+	}
+	if activity == nil {
+		return nil, fmt.Errorf("activity not found")
+	}
+
+	if input.TokenRequired != nil {
+		activity.CheckInTokenRequired = *input.TokenRequired
+	}
+	if input.TokenPeriodSeconds != nil {
+		activity.CheckInTokenPeriodSeconds = *input.TokenPeriodSeconds
+	}
+	if input.WindowEnabled != nil {
+		activity.CheckInWindowEnabled = *input.WindowEnabled
+	}
+	if input.OpensBeforeMinutes != nil {
+		activity.CheckInOpensBeforeMinutes = *input.OpensBeforeMinutes
+	}
+	if input.ClosesAfterMinutes != nil {
+		activity.CheckInClosesAfterMinutes = *input.ClosesAfterMinutes
+	}
+
+	if err := activity.ValidateCheckInSettings(); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.activityRepo.UpdateCheckInSettings(ctx, activity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update check-in settings: %w", err)
+	}
+
+	return &Output{Activity: updated}, nil
+}
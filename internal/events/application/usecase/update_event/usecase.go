@@ -0,0 +1,108 @@
+package updateevent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+// Input traz apenas os campos alterados; nil mantém o valor atual
+type Input struct {
+	UserID         string
+	EventID        string
+	Name           *string
+	AllowedDomains *[]string
+	Description    *string
+	StartDate      *time.Time
+	EndDate        *time.Time
+	// exige inscrição prévia para o check-in
+	RegistrationRequired *bool
+}
+
+type Output struct {
+	Event *entity.Event
+}
+
+type UseCase struct {
+	eventRepo    repository.EventRepository
+	activityRepo repository.ActivityRepository
+	userAuthSvc  service.UserAuthorizationService
+}
+
+func NewUseCase(eventRepo repository.EventRepository, activityRepo repository.ActivityRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		eventRepo:    eventRepo,
+		activityRepo: activityRepo,
+		userAuthSvc:  userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// 1. Verificar se é admin
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	// 2. Buscar evento
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	if !event.IsEditable() {
+		return nil, entity.ErrEventNotEditable
+	}
+
+	// 3. Validar as novas datas junto com as atuais
+	if input.StartDate != nil {
+		event.StartDate = *input.StartDate
+	}
+	if input.EndDate != nil {
+		event.EndDate = *input.EndDate
+	}
+
+	if !event.IsStartDateBeforeEndDate() {
+		return nil, fmt.Errorf("start date must be before end date")
+	}
+
+	// 4. As atividades precisam continuar dentro do período do evento
+	if input.StartDate != nil || input.EndDate != nil {
+		activities, err := uc.activityRepo.FindByEventID(ctx, event.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find activities by event ID: %w", err)
+		}
+
+		for _, activity := range activities {
+			if !event.ContainsActivity(activity) {
+				return nil, fmt.Errorf("event dates must include all of its activities")
+			}
+		}
+	}
+
+	// 5. Persistir
+	//nolint:exhaustruct
+	updated, err := uc.eventRepo.PartialUpdate(ctx, event.ID, repository.UpdateEventInput{
+		Name:                 input.Name,
+		AllowedDomains:       input.AllowedDomains,
+		Description:          input.Description,
+		StartDate:            input.StartDate,
+		EndDate:              input.EndDate,
+		RegistrationRequired: input.RegistrationRequired,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update event: %w", err)
+	}
+
+	return &Output{Event: updated}, nil
+}
@@ -0,0 +1,69 @@
+package updateeventvenue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+// Input define o local do evento; Venue nil remove o local (e a cerca geográfica)
+type Input struct {
+	UserID  string
+	EventID string
+	Venue   *entity.NewVenueParams
+}
+
+type Output struct {
+	Event *entity.Event
+}
+
+type UseCase struct {
+	eventRepo   repository.EventRepository
+	userAuthSvc service.UserAuthorizationService
+}
+
+func NewUseCase(eventRepo repository.EventRepository, userAuthSvc service.UserAuthorizationService) *UseCase {
+	return &UseCase{
+		eventRepo:   eventRepo,
+		userAuthSvc: userAuthSvc,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	var venue entity.Venue
+	if input.Venue != nil {
+		venue, err = entity.NewVenue(*input.Venue)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	//nolint:exhaustruct
+	updated, err := uc.eventRepo.PartialUpdate(ctx, event.ID, repository.UpdateEventInput{
+		Venue: &venue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update event venue: %w", err)
+	}
+
+	return &Output{Event: updated}, nil
+}
@@ -0,0 +1,207 @@
+package upsertcertificatetemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/storage"
+)
+
+const maxImageSize = 1 << 20 // 1MB
+
+var ErrInvalidImage = errors.New("invalid image")
+
+// ImageInput é uma imagem enviada para o template
+// Um ImageInput nil mantém a imagem atual; Content vazio remove a imagem
+type ImageInput struct {
+	Content []byte
+}
+
+type SignatoryInput struct {
+	Name  string
+	Title string
+	// SignatureImage segue a regra de ImageInput, comparando com o signatário na mesma posição
+	SignatureImage *ImageInput
+}
+
+type Input struct {
+	UserID         string
+	EventID        string
+	Title          string
+	BodyText       string
+	Signatories    []SignatoryInput
+	Logo           *ImageInput
+	PrimaryColor   string
+	AccentColor    string
+	SecondaryColor string
+}
+
+type Output struct {
+	Template *entity.CertificateTemplate
+}
+
+type UseCase struct {
+	templateRepo repository.CertificateTemplateRepository
+	eventRepo    repository.EventRepository
+	blobStorage  storage.BlobStorage
+	userAuthSvc  service.UserAuthorizationService
+}
+
+func NewUseCase(
+	templateRepo repository.CertificateTemplateRepository,
+	eventRepo repository.EventRepository,
+	blobStorage storage.BlobStorage,
+	userAuthSvc service.UserAuthorizationService,
+) *UseCase {
+	return &UseCase{
+		templateRepo: templateRepo,
+		eventRepo:    eventRepo,
+		blobStorage:  blobStorage,
+		userAuthSvc:  userAuthSvc,
+	}
+}
+
+// upload é uma imagem nova que precisa ser enviada ao blob storage
+type upload struct {
+	key         string
+	content     []byte
+	contentType string
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	isAdmin, err := uc.userAuthSvc.IsUserAdmin(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user role: %w", err)
+	}
+	if !isAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
+
+	event, err := uc.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("event not found")
+	}
+
+	existing, err := uc.templateRepo.FindByEventID(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find certificate template: %w", err)
+	}
+	if existing == nil {
+		existing = entity.DefaultCertificateTemplate(event.ID)
+	}
+
+	var uploads []upload
+
+	// Resolve o logo
+	logoKey, logoUpload, err := uc.resolveImage(event.ID, input.Logo, existing.LogoKey)
+	if err != nil {
+		return nil, err
+	}
+	if logoUpload != nil {
+		uploads = append(uploads, *logoUpload)
+	}
+
+	// Resolve os signatários e suas assinaturas
+	signatories := make([]entity.CertificateSignatory, len(input.Signatories))
+	for i, s := range input.Signatories {
+		var currentKey *string
+		if i < len(existing.Signatories) {
+			currentKey = existing.Signatories[i].SignatureKey
+		}
+
+		signatureKey, signatureUpload, err := uc.resolveImage(event.ID, s.SignatureImage, currentKey)
+		if err != nil {
+			return nil, err
+		}
+		if signatureUpload != nil {
+			uploads = append(uploads, *signatureUpload)
+		}
+
+		signatories[i] = entity.CertificateSignatory{
+			Name:         s.Name,
+			Title:        s.Title,
+			SignatureKey: signatureKey,
+		}
+	}
+
+	template, err := entity.NewCertificateTemplate(entity.NewCertificateTemplateParams{
+		EventID:        event.ID,
+		Title:          input.Title,
+		BodyText:       input.BodyText,
+		Signatories:    signatories,
+		LogoKey:        logoKey,
+		PrimaryColor:   input.PrimaryColor,
+		AccentColor:    input.AccentColor,
+		SecondaryColor: input.SecondaryColor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Envia as imagens novas antes de salvar o template que as referencia
+	for _, u := range uploads {
+		if err := uc.blobStorage.Put(ctx, u.key, u.content, u.contentType); err != nil {
+			return nil, fmt.Errorf("failed to store certificate template image: %w", err)
+		}
+	}
+
+	saved, err := uc.templateRepo.Upsert(ctx, template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save certificate template: %w", err)
+	}
+
+	// Remove as imagens que deixaram de ser usadas (best-effort)
+	keys := saved.ImageKeys()
+	for _, key := range existing.ImageKeys() {
+		if !slices.Contains(keys, key) {
+			_ = uc.blobStorage.Delete(ctx, key)
+		}
+	}
+
+	return &Output{Template: saved}, nil
+}
+
+// resolveImage decide a chave final da imagem e, se houver uma imagem nova, o upload necessário
+func (uc *UseCase) resolveImage(eventID string, image *ImageInput, currentKey *string) (*string, *upload, error) {
+	if image == nil {
+		return currentKey, nil, nil
+	}
+
+	if len(image.Content) == 0 {
+		return nil, nil, nil
+	}
+
+	if len(image.Content) > maxImageSize {
+		return nil, nil, fmt.Errorf("%w: maximum size is 1MB", ErrInvalidImage)
+	}
+
+	var ext string
+	contentType := http.DetectContentType(image.Content)
+	switch contentType {
+	case "image/png":
+		ext = "png"
+	case "image/jpeg":
+		ext = "jpg"
+	default:
+		return nil, nil, fmt.Errorf("%w: only PNG and JPEG are supported", ErrInvalidImage)
+	}
+
+	id, err := lib.GenerateID(lib.UUID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate image ID: %w", err)
+	}
+
+	key := fmt.Sprintf("certificate-templates/%s/%s.%s", eventID, id, ext)
+
+	return &key, &upload{key: key, content: image.Content, contentType: contentType}, nil
+}
@@ -0,0 +1,46 @@
+package verifycertificate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+)
+
+type Input struct {
+	Code string
+}
+
+type Output struct {
+	Certificate *entity.Certificate
+}
+
+type UseCase struct {
+	certificateRepo repository.CertificateRepository
+}
+
+func NewUseCase(certificateRepo repository.CertificateRepository) *UseCase {
+	return &UseCase{
+		certificateRepo: certificateRepo,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	code := lib.NormalizeVerificationCode(input.Code)
+	if code == "" {
+		return nil, fmt.Errorf("certificate not found")
+	}
+
+	certificate, err := uc.certificateRepo.FindByVerificationCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find certificate: %w", err)
+	}
+
+	if certificate == nil {
+		return nil, fmt.Errorf("certificate not found")
+	}
+
+	return &Output{Certificate: certificate}, nil
+}
@@ -1,21 +1,60 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
 )
 
+// Limites do período de rotação do token de check-in (em segundos)
+const (
+	DefaultCheckInTokenPeriod = 30
+	MinCheckInTokenPeriod     = 10
+	MaxCheckInTokenPeriod     = 300
+)
+
+// Tolerâncias padrão da janela de check-in (em minutos)
+const (
+	DefaultCheckInOpensBefore = 15
+	DefaultCheckInClosesAfter = 15
+	MaxCheckInGrace           = 24 * 60
+)
+
+var (
+	ErrInvalidCheckInSettings = errors.New("invalid check-in settings")
+	ErrCheckInOutsideWindow   = errors.New("check-in not allowed outside activity time")
+
+	ErrActivityInvalidDates = errors.New("start date must be before end date")
+	ErrActivityOutsideEvent = errors.New("activity must be within the event dates")
+	ErrActivityTrackOverlap = errors.New("activity overlaps another activity in the same track")
+	ErrInvalidActivities    = errors.New("invalid activities")
+)
+
 type Activity struct {
-	ID          string     `db:"id"`
-	Name        string     `db:"name"`
-	EventID     string     `db:"event_id"`
-	Description *string    `db:"description"`
-	StartDate   time.Time  `db:"start_date"`
-	EndDate     time.Time  `db:"end_date"`
-	CreatedAt   time.Time  `db:"created_at"`
-	UpdatedAt   *time.Time `db:"updated_at"`
+	ID          string    `db:"id"`
+	Name        string    `db:"name"`
+	EventID     string    `db:"event_id"`
+	Description *string   `db:"description"`
+	StartDate   time.Time `db:"start_date"`
+	EndDate     time.Time `db:"end_date"`
+	// trilha (sala) opcional; atividades da mesma trilha não podem ter horários sobrepostos
+	Track *string `db:"track"`
+	// número máximo de inscritos; nulo é sem limite
+	Capacity  *int       `db:"capacity"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt *time.Time `db:"updated_at"`
+	// check-in presencial: exige o token rotativo exibido no QR code da atividade
+	CheckInTokenRequired      bool `db:"checkin_token_required"`
+	CheckInTokenPeriodSeconds int  `db:"checkin_token_period_seconds"`
+	// janela de check-in: abre antes do início e fecha depois do fim da atividade
+	CheckInWindowEnabled      bool `db:"checkin_window_enabled"`
+	CheckInOpensBeforeMinutes int  `db:"checkin_opens_before_minutes"`
+	CheckInClosesAfterMinutes int  `db:"checkin_closes_after_minutes"`
+	// local da atividade; quando não configurado, vale o local do evento
+	Venue
 }
 
 type NewActivityParams struct {
@@ -24,6 +63,9 @@ type NewActivityParams struct {
 	Description *string
 	StartDate   time.Time
 	EndDate     time.Time
+	Track       *string
+	Capacity    *int
+	Venue       Venue
 }
 
 func NewActivity(params NewActivityParams) (*Activity, error) {
@@ -39,8 +81,17 @@ func NewActivity(params NewActivityParams) (*Activity, error) {
 		Description: params.Description,
 		StartDate:   params.StartDate,
 		EndDate:     params.EndDate,
+		Track:       normalizeTrack(params.Track),
+		Capacity:    normalizeCapacity(params.Capacity),
 		CreatedAt:   time.Now(),
 		UpdatedAt:   nil,
+
+		CheckInTokenRequired:      false,
+		CheckInTokenPeriodSeconds: DefaultCheckInTokenPeriod,
+		CheckInWindowEnabled:      true,
+		CheckInOpensBeforeMinutes: DefaultCheckInOpensBefore,
+		CheckInClosesAfterMinutes: DefaultCheckInClosesAfter,
+		Venue:                     params.Venue,
 	}, nil
 }
 
@@ -54,6 +105,9 @@ func (a *Activity) Update(params NewActivityParams) error {
 	a.Description = params.Description
 	a.StartDate = params.StartDate
 	a.EndDate = params.EndDate
+	a.Track = normalizeTrack(params.Track)
+	a.Capacity = normalizeCapacity(params.Capacity)
+	a.Venue = params.Venue
 	a.touch()
 	return nil
 }
@@ -68,6 +122,68 @@ func (a *Activity) IsEndDateAfterStartDate() bool {
 	return a.EndDate.After(a.StartDate)
 }
 
+// normalizeTrack remove espaços; trilha vazia significa sem trilha
+func normalizeTrack(track *string) *string {
+	if track == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*track)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// normalizeCapacity trata capacidade zero ou negativa como sem limite
+func normalizeCapacity(capacity *int) *int {
+	if capacity == nil || *capacity <= 0 {
+		return nil
+	}
+	c := *capacity
+	return &c
+}
+
+// HasCapacity indica se a atividade limita o número de inscritos
+func (a *Activity) HasCapacity() bool {
+	return a.Capacity != nil
+}
+
+// IsFull indica se a atividade já atingiu a capacidade com o número de inscritos informado
+func (a *Activity) IsFull(registered int) bool {
+	return a.HasCapacity() && registered >= *a.Capacity
+}
+
+// OverlapsInTrack indica se as duas atividades estão na mesma trilha com horários sobrepostos
+func (a *Activity) OverlapsInTrack(other *Activity) bool {
+	if a.ID == other.ID || a.Track == nil || other.Track == nil {
+		return false
+	}
+	if !strings.EqualFold(*a.Track, *other.Track) {
+		return false
+	}
+	return a.StartDate.Before(other.EndDate) && other.StartDate.Before(a.EndDate)
+}
+
+// ValidateSchedule verifica as datas da atividade em relação ao evento e às demais atividades da trilha
+// e retorna todos os problemas encontrados
+func (a *Activity) ValidateSchedule(event *Event, others []*Activity) []error {
+	var errs []error
+
+	if !a.IsStartDateBeforeEndDate() {
+		errs = append(errs, ErrActivityInvalidDates)
+	}
+	if !event.ContainsActivity(a) {
+		errs = append(errs, ErrActivityOutsideEvent)
+	}
+	for _, other := range others {
+		if a.OverlapsInTrack(other) {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrActivityTrackOverlap, other.Name))
+		}
+	}
+
+	return errs
+}
+
 func (a *Activity) HasStarted() bool {
 	return a.StartDate.Before(time.Now())
 }
@@ -76,6 +192,73 @@ func (a *Activity) HasEnded() bool {
 	return a.EndDate.Before(time.Now())
 }
 
+// horário de abertura do check-in (início menos a tolerância)
+func (a *Activity) CheckInOpensAt() time.Time {
+	return a.StartDate.Add(-time.Duration(a.CheckInOpensBeforeMinutes) * time.Minute)
+}
+
+// horário de encerramento do check-in (fim mais a tolerância)
+func (a *Activity) CheckInClosesAt() time.Time {
+	return a.EndDate.Add(time.Duration(a.CheckInClosesAfterMinutes) * time.Minute)
+}
+
 func (a *Activity) IsCheckInAllowed(checkInTime time.Time) bool {
-	return a.HasStarted() && !a.HasEnded()
+	if !a.CheckInWindowEnabled {
+		return true
+	}
+	return !checkInTime.Before(a.CheckInOpensAt()) && !checkInTime.After(a.CheckInClosesAt())
+}
+
+// ValidateCheckInTime retorna ErrCheckInOutsideWindow quando o horário está fora da janela de check-in
+func (a *Activity) ValidateCheckInTime(checkInTime time.Time) error {
+	if a.IsCheckInAllowed(checkInTime) {
+		return nil
+	}
+	return fmt.Errorf("%w: check-in opens at %s and closes at %s", ErrCheckInOutsideWindow,
+		a.CheckInOpensAt().Format(time.RFC3339), a.CheckInClosesAt().Format(time.RFC3339))
+}
+
+// carga horária programada da atividade
+func (a *Activity) Workload() time.Duration {
+	return a.EndDate.Sub(a.StartDate)
+}
+
+// período de validade de cada token de check-in
+func (a *Activity) CheckInTokenPeriod() time.Duration {
+	return time.Duration(a.CheckInTokenPeriodSeconds) * time.Second
+}
+
+// ValidateCheckInSettings verifica as configurações de check-in da atividade
+func (a *Activity) ValidateCheckInSettings() error {
+	if a.CheckInTokenPeriodSeconds < MinCheckInTokenPeriod || a.CheckInTokenPeriodSeconds > MaxCheckInTokenPeriod {
+		return fmt.Errorf("%w: token period must be between %d and %d seconds", ErrInvalidCheckInSettings, MinCheckInTokenPeriod, MaxCheckInTokenPeriod)
+	}
+	if a.CheckInOpensBeforeMinutes < 0 || a.CheckInOpensBeforeMinutes > MaxCheckInGrace {
+		return fmt.Errorf("%w: opens before must be between 0 and %d minutes", ErrInvalidCheckInSettings, MaxCheckInGrace)
+	}
+	if a.CheckInClosesAfterMinutes < 0 || a.CheckInClosesAfterMinutes > MaxCheckInGrace {
+		return fmt.Errorf("%w: closes after must be between 0 and %d minutes", ErrInvalidCheckInSettings, MaxCheckInGrace)
+	}
+	return nil
+}
+
+// ActivityValidationError reúne os problemas de uma atividade de um lote
+type ActivityValidationError struct {
+	// posição da atividade no lote
+	Index  int
+	Name   string
+	Errors []error
+}
+
+// ActivityBatchError é retornado quando uma ou mais atividades de um lote são inválidas
+type ActivityBatchError struct {
+	Activities []ActivityValidationError
+}
+
+func (e *ActivityBatchError) Error() string {
+	return ErrInvalidActivities.Error()
+}
+
+func (e *ActivityBatchError) Unwrap() error {
+	return ErrInvalidActivities
 }
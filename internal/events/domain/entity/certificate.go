@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+)
+
+const verificationCodeLength = 10
+
+type Certificate struct {
+	ID               string     `db:"id"`
+	JobID            string     `db:"job_id"`
+	VerificationCode string     `db:"verification_code"`
+	UserID           string     `db:"user_id"`
+	EventID          string     `db:"event_id"`
+	ActivityID       *string    `db:"activity_id"`
+	RecipientName    string     `db:"recipient_name"`
+	EventName        string     `db:"event_name"`
+	ActivityName     *string    `db:"activity_name"`
+	WorkloadMinutes  int        `db:"workload_minutes"`
+	IssuedAt         time.Time  `db:"issued_at"`
+	RevokedAt        *time.Time `db:"revoked_at"`
+	RevokedReason    *string    `db:"revoked_reason"`
+	StorageKey       *string    `db:"storage_key"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        *time.Time `db:"updated_at"`
+}
+
+type NewCertificateParams struct {
+	JobID         string
+	UserID        string
+	EventID       string
+	ActivityID    *string
+	RecipientName string
+	EventName     string
+	ActivityName  *string
+	Workload      time.Duration
+}
+
+func NewCertificate(params NewCertificateParams) (*Certificate, error) {
+	id, err := lib.GenerateID(lib.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate certificate ID: %w", err)
+	}
+
+	code, err := lib.GenerateVerificationCode(verificationCodeLength)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &Certificate{
+		ID:               id,
+		JobID:            params.JobID,
+		VerificationCode: code,
+		UserID:           params.UserID,
+		EventID:          params.EventID,
+		ActivityID:       params.ActivityID,
+		RecipientName:    params.RecipientName,
+		EventName:        params.EventName,
+		ActivityName:     params.ActivityName,
+		WorkloadMinutes:  int(params.Workload.Minutes()),
+		IssuedAt:         now,
+		RevokedAt:        nil,
+		RevokedReason:    nil,
+		StorageKey:       nil,
+		CreatedAt:        now,
+		UpdatedAt:        nil,
+	}, nil
+}
+
+// um certificado é válido enquanto não for revogado
+func (c *Certificate) IsValid() bool {
+	return c.RevokedAt == nil
+}
+
+// HasFile indica se o PDF do certificado já foi armazenado
+func (c *Certificate) HasFile() bool {
+	return c.StorageKey != nil
+}
+
+// FileKey retorna a chave usada para armazenar o PDF do certificado
+func (c *Certificate) FileKey() string {
+	return fmt.Sprintf("certificates/%s/%s.pdf", c.EventID, c.ID)
+}
+
+func (c *Certificate) Workload() time.Duration {
+	return time.Duration(c.WorkloadMinutes) * time.Minute
+}
+
+// FormatWorkload formata a carga horária por extenso (ex: "2 horas e 30 minutos")
+func FormatWorkload(duration time.Duration) string {
+	hours := int(duration.Hours())
+	minutes := int(duration.Minutes()) % 60
+
+	if minutes == 0 {
+		if hours == 1 {
+			return "1 hora"
+		}
+		return fmt.Sprintf("%d horas", hours)
+	}
+
+	if hours == 0 {
+		if minutes == 1 {
+			return "1 minuto"
+		}
+		return fmt.Sprintf("%d minutos", minutes)
+	}
+
+	hoursLabel := "hora"
+	if hours > 1 {
+		hoursLabel = "horas"
+	}
+
+	minutesLabel := "minuto"
+	if minutes > 1 {
+		minutesLabel = "minutos"
+	}
+
+	return fmt.Sprintf("%d %s e %d %s", hours, hoursLabel, minutes, minutesLabel)
+}
@@ -0,0 +1,207 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+)
+
+// Placeholders aceitos no texto do certificado
+const (
+	PlaceholderParticipantName = "{{participant_name}}"
+	PlaceholderEventName       = "{{event_name}}"
+	PlaceholderActivityName    = "{{activity_name}}"
+	PlaceholderEventDate       = "{{event_date}}"
+	PlaceholderWorkload        = "{{workload}}"
+	PlaceholderIssueDate       = "{{issue_date}}"
+)
+
+// Valores usados quando o evento não tem um template configurado
+const (
+	DefaultCertificateTitle          = "CERTIFICADO"
+	DefaultCertificateBodyText       = "Certificamos que {{participant_name}} participou do evento {{event_name}}, realizado em {{event_date}}, com carga horária total de {{workload}}."
+	DefaultCertificatePrimaryColor   = "#1a1a2e"
+	DefaultCertificateAccentColor    = "#c9a227"
+	DefaultCertificateSecondaryColor = "#0f3460"
+)
+
+const MaxCertificateSignatories = 3
+
+var (
+	ErrInvalidCertificatePlaceholder = errors.New("invalid certificate placeholder")
+	ErrTooManyCertificateSignatories = errors.New("too many certificate signatories")
+)
+
+var (
+	placeholderPattern = regexp.MustCompile(`\{\{[^{}]*\}\}`)
+	validPlaceholders  = []string{
+		PlaceholderParticipantName,
+		PlaceholderEventName,
+		PlaceholderActivityName,
+		PlaceholderEventDate,
+		PlaceholderWorkload,
+		PlaceholderIssueDate,
+	}
+)
+
+type CertificateSignatory struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+	// SignatureKey é a chave da imagem da assinatura no blob storage
+	SignatureKey *string `json:"signature_key,omitempty"`
+}
+
+// CertificateSignatories é armazenado como JSONB
+type CertificateSignatories []CertificateSignatory
+
+func (s CertificateSignatories) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (s *CertificateSignatories) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*s = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return fmt.Errorf("unsupported type for certificate signatories: %T", src)
+	}
+}
+
+type CertificateTemplate struct {
+	ID             string                 `db:"id"`
+	EventID        string                 `db:"event_id"`
+	Title          string                 `db:"title"`
+	BodyText       string                 `db:"body_text"`
+	Signatories    CertificateSignatories `db:"signatories"`
+	LogoKey        *string                `db:"logo_key"`
+	PrimaryColor   string                 `db:"primary_color"`
+	AccentColor    string                 `db:"accent_color"`
+	SecondaryColor string                 `db:"secondary_color"`
+	CreatedAt      time.Time              `db:"created_at"`
+	UpdatedAt      *time.Time             `db:"updated_at"`
+}
+
+type NewCertificateTemplateParams struct {
+	EventID        string
+	Title          string
+	BodyText       string
+	Signatories    []CertificateSignatory
+	LogoKey        *string
+	PrimaryColor   string
+	AccentColor    string
+	SecondaryColor string
+}
+
+func NewCertificateTemplate(params NewCertificateTemplateParams) (*CertificateTemplate, error) {
+	id, err := lib.GenerateID(lib.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate certificate template ID: %w", err)
+	}
+
+	template := &CertificateTemplate{
+		ID:             id,
+		EventID:        params.EventID,
+		Title:          valueOrDefault(params.Title, DefaultCertificateTitle),
+		BodyText:       valueOrDefault(params.BodyText, DefaultCertificateBodyText),
+		Signatories:    params.Signatories,
+		LogoKey:        params.LogoKey,
+		PrimaryColor:   valueOrDefault(params.PrimaryColor, DefaultCertificatePrimaryColor),
+		AccentColor:    valueOrDefault(params.AccentColor, DefaultCertificateAccentColor),
+		SecondaryColor: valueOrDefault(params.SecondaryColor, DefaultCertificateSecondaryColor),
+		CreatedAt:      time.Now(),
+		UpdatedAt:      nil,
+	}
+
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// DefaultCertificateTemplate retorna o template usado quando o evento não tem um configurado
+func DefaultCertificateTemplate(eventID string) *CertificateTemplate {
+	return &CertificateTemplate{
+		ID:             "",
+		EventID:        eventID,
+		Title:          DefaultCertificateTitle,
+		BodyText:       DefaultCertificateBodyText,
+		Signatories:    CertificateSignatories{},
+		LogoKey:        nil,
+		PrimaryColor:   DefaultCertificatePrimaryColor,
+		AccentColor:    DefaultCertificateAccentColor,
+		SecondaryColor: DefaultCertificateSecondaryColor,
+		CreatedAt:      time.Time{},
+		UpdatedAt:      nil,
+	}
+}
+
+// IsDefault indica se o template não foi salvo (template padrão)
+func (t *CertificateTemplate) IsDefault() bool {
+	return t.ID == ""
+}
+
+// verifica o número de signatários e se o texto usa apenas placeholders conhecidos
+func (t *CertificateTemplate) Validate() error {
+	if len(t.Signatories) > MaxCertificateSignatories {
+		return fmt.Errorf("%w: maximum is %d", ErrTooManyCertificateSignatories, MaxCertificateSignatories)
+	}
+
+	for _, placeholder := range placeholderPattern.FindAllString(t.BodyText, -1) {
+		if !isValidPlaceholder(placeholder) {
+			return fmt.Errorf("%w: %s", ErrInvalidCertificatePlaceholder, placeholder)
+		}
+	}
+
+	return nil
+}
+
+// ImageKeys retorna as chaves de todas as imagens usadas pelo template
+func (t *CertificateTemplate) ImageKeys() []string {
+	keys := make([]string, 0, len(t.Signatories)+1)
+	if t.LogoKey != nil {
+		keys = append(keys, *t.LogoKey)
+	}
+	for _, s := range t.Signatories {
+		if s.SignatureKey != nil {
+			keys = append(keys, *s.SignatureKey)
+		}
+	}
+	return keys
+}
+
+// CertificatePlaceholders retorna os placeholders aceitos no texto do certificado
+func CertificatePlaceholders() []string {
+	return slices.Clone(validPlaceholders)
+}
+
+func isValidPlaceholder(placeholder string) bool {
+	return slices.Contains(validPlaceholders, placeholder)
+}
+
+func valueOrDefault(value, fallback string) string {
+	if strings.TrimSpace(value) == "" {
+		return fallback
+	}
+	return value
+}
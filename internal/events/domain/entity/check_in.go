@@ -1,22 +1,38 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
 )
 
+var ErrAlreadyCheckedIn = errors.New("user already checked in")
+
 type CheckIn struct {
-	ID         string    `db:"id"`
-	UserID     string    `db:"user_id"`
-	ActivityID string    `db:"activity_id"`
-	CheckedAt  time.Time `db:"checked_at"`
+	ID         string    `db:"id" json:"id"`
+	UserID     string    `db:"user_id" json:"user_id"`
+	ActivityID string    `db:"activity_id" json:"activity_id"`
+	CheckedAt  time.Time `db:"checked_at" json:"checked_at"`
+	// preenchidos no check-out
+	CheckedOutAt    *time.Time `db:"checked_out_at" json:"checked_out_at"`
+	AttendedMinutes *int       `db:"attended_minutes" json:"attended_minutes"`
+	// preenchidos quando um admin registra o check-in em nome do participante
+	RegisteredBy       *string `db:"registered_by" json:"registered_by"`
+	RegistrationReason *string `db:"registration_reason" json:"registration_reason"`
+	// preenchidos quando um admin revoga o check-in; o registro é mantido para auditoria
+	RevokedAt        *time.Time `db:"revoked_at" json:"revoked_at"`
+	RevokedBy        *string    `db:"revoked_by" json:"revoked_by"`
+	RevocationReason *string    `db:"revocation_reason" json:"revocation_reason"`
 }
 
 type NewCheckInParams struct {
 	UserID     string
 	ActivityID string
+	// opcionais, apenas para check-in manual
+	RegisteredBy       *string
+	RegistrationReason *string
 }
 
 func NewCheckIn(params NewCheckInParams) (*CheckIn, error) {
@@ -26,9 +42,82 @@ func NewCheckIn(params NewCheckInParams) (*CheckIn, error) {
 	}
 
 	return &CheckIn{
-		ID:         id,
-		UserID:     params.UserID,
-		ActivityID: params.ActivityID,
-		CheckedAt:  time.Now(),
+		ID:                 id,
+		UserID:             params.UserID,
+		ActivityID:         params.ActivityID,
+		CheckedAt:          time.Now(),
+		CheckedOutAt:       nil,
+		AttendedMinutes:    nil,
+		RegisteredBy:       params.RegisteredBy,
+		RegistrationReason: params.RegistrationReason,
+		RevokedAt:          nil,
+		RevokedBy:          nil,
+		RevocationReason:   nil,
 	}, nil
 }
+
+// IsManual indica se o check-in foi registrado por um admin
+func (c *CheckIn) IsManual() bool {
+	return c.RegisteredBy != nil
+}
+
+func (c *CheckIn) IsRevoked() bool {
+	return c.RevokedAt != nil
+}
+
+// Revoke desfaz o check-in sem apagá-lo, guardando quem revogou e o motivo
+func (c *CheckIn) Revoke(revokedBy, reason string, revokedAt time.Time) error {
+	if c.IsRevoked() {
+		return fmt.Errorf("check-in already revoked")
+	}
+
+	c.RevokedAt = &revokedAt
+	c.RevokedBy = &revokedBy
+	c.RevocationReason = &reason
+
+	return nil
+}
+
+func (c *CheckIn) HasCheckedOut() bool {
+	return c.CheckedOutAt != nil
+}
+
+// CheckOut registra a saída do participante e guarda o tempo de permanência na atividade
+func (c *CheckIn) CheckOut(activity *Activity, checkedOutAt time.Time) error {
+	if c.HasCheckedOut() {
+		return fmt.Errorf("user already checked out")
+	}
+
+	if checkedOutAt.Before(c.CheckedAt) {
+		return fmt.Errorf("check-out before check-in")
+	}
+
+	c.CheckedOutAt = &checkedOutAt
+	minutes := int(c.AttendedDuration(activity).Minutes())
+	c.AttendedMinutes = &minutes
+
+	return nil
+}
+
+// AttendedDuration retorna o tempo de permanência dentro do horário da atividade
+// sem check-out não há como saber quando o participante saiu, então nenhum tempo é creditado
+func (c *CheckIn) AttendedDuration(activity *Activity) time.Duration {
+	if c.CheckedOutAt == nil {
+		return 0
+	}
+
+	start := c.CheckedAt
+	if start.Before(activity.StartDate) {
+		start = activity.StartDate
+	}
+
+	end := activity.EndDate
+	if c.CheckedOutAt.Before(end) {
+		end = *c.CheckedOutAt
+	}
+
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
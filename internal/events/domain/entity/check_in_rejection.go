@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+)
+
+// Enum motivo da recusa de um check-in
+type CheckInRejectionReason string
+
+const (
+	// o participante não enviou a localização
+	CheckInRejectionLocationMissing CheckInRejectionReason = "location_missing"
+	// a localização enviada está fora do raio do local
+	CheckInRejectionOutsideVenue CheckInRejectionReason = "outside_venue"
+)
+
+// CheckInRejection registra uma tentativa de check-in recusada, para auditoria
+type CheckInRejection struct {
+	ID             string                 `db:"id"`
+	UserID         string                 `db:"user_id"`
+	ActivityID     string                 `db:"activity_id"`
+	Reason         CheckInRejectionReason `db:"reason"`
+	Latitude       *float64               `db:"latitude"`
+	Longitude      *float64               `db:"longitude"`
+	DistanceMeters *float64               `db:"distance_meters"`
+	CreatedAt      time.Time              `db:"created_at"`
+}
+
+type NewCheckInRejectionParams struct {
+	UserID         string
+	ActivityID     string
+	Reason         CheckInRejectionReason
+	Latitude       *float64
+	Longitude      *float64
+	DistanceMeters *float64
+}
+
+func NewCheckInRejection(params NewCheckInRejectionParams) (*CheckInRejection, error) {
+	id, err := lib.GenerateID(lib.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate check-in rejection ID: %w", err)
+	}
+
+	return &CheckInRejection{
+		ID:             id,
+		UserID:         params.UserID,
+		ActivityID:     params.ActivityID,
+		Reason:         params.Reason,
+		Latitude:       params.Latitude,
+		Longitude:      params.Longitude,
+		DistanceMeters: params.DistanceMeters,
+		CreatedAt:      time.Now(),
+	}, nil
+}
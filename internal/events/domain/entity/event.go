@@ -1,7 +1,9 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -19,16 +21,116 @@ const (
 	EventStatusCompleted EventStatus = "completed"
 )
 
+var (
+	ErrInvalidStatusTransition = errors.New("invalid event status transition")
+	ErrEventNotOpenForCheckIn  = errors.New("event is not open for check-in")
+	ErrEventNotEditable        = errors.New("completed events cannot be edited")
+)
+
+// eventTransitions define para quais status cada status pode ir
+var eventTransitions = map[EventStatus][]EventStatus{
+	EventStatusDraft:     {EventStatusPublished, EventStatusCancelled},
+	EventStatusPublished: {EventStatusCancelled, EventStatusCompleted},
+	EventStatusCancelled: {EventStatusPublished},
+	EventStatusCompleted: {EventStatusPublished},
+}
+
+// Enum modo de emissão de certificados do evento
+type CertificateMode string
+
+const (
+	// um certificado por atividade com check-in
+	CertificateModePerActivity CertificateMode = "per_activity"
+	// um único certificado por participante, somando a carga horária das atividades
+	CertificateModeConsolidated CertificateMode = "consolidated"
+	// os dois tipos de certificado
+	CertificateModeBoth CertificateMode = "both"
+)
+
+func (m CertificateMode) IsValid() bool {
+	switch m {
+	case CertificateModePerActivity, CertificateModeConsolidated, CertificateModeBoth:
+		return true
+	}
+	return false
+}
+
+// Enum origem da carga horária dos certificados
+type WorkloadSource string
+
+const (
+	// horário programado da atividade (fim - início)
+	WorkloadSourceScheduled WorkloadSource = "scheduled"
+	// tempo de permanência entre check-in e check-out; check-ins sem check-out contam zero
+	WorkloadSourceAttended WorkloadSource = "attended"
+)
+
+func (s WorkloadSource) IsValid() bool {
+	switch s {
+	case WorkloadSourceScheduled, WorkloadSourceAttended:
+		return true
+	}
+	return false
+}
+
+// Enum regra de frequência mínima para receber certificado
+type AttendanceRule string
+
+const (
+	AttendanceRuleNone AttendanceRule = "none"
+	// AttendanceThreshold é o número mínimo de atividades
+	AttendanceRuleMinActivities AttendanceRule = "min_activities"
+	// AttendanceThreshold é a porcentagem mínima (0-100) das atividades do evento
+	AttendanceRuleMinPercentage AttendanceRule = "min_percentage"
+	// AttendanceThreshold é o número mínimo de horas somadas
+	AttendanceRuleMinHours AttendanceRule = "min_hours"
+)
+
+var ErrInvalidAttendanceRule = errors.New("invalid attendance rule")
+
+// ValidateAttendanceRule verifica se o limite faz sentido para a regra
+func ValidateAttendanceRule(rule AttendanceRule, threshold float64) error {
+	switch rule {
+	case AttendanceRuleNone:
+		return nil
+	case AttendanceRuleMinActivities:
+		if threshold < 1 || threshold != math.Trunc(threshold) {
+			return fmt.Errorf("%w: min_activities requires a positive integer threshold", ErrInvalidAttendanceRule)
+		}
+	case AttendanceRuleMinPercentage:
+		if threshold <= 0 || threshold > 100 {
+			return fmt.Errorf("%w: min_percentage requires a threshold between 0 and 100", ErrInvalidAttendanceRule)
+		}
+	case AttendanceRuleMinHours:
+		if threshold <= 0 {
+			return fmt.Errorf("%w: min_hours requires a positive threshold", ErrInvalidAttendanceRule)
+		}
+	default:
+		return fmt.Errorf("%w: unknown rule %s", ErrInvalidAttendanceRule, rule)
+	}
+	return nil
+}
+
 type Event struct {
-	ID             string         `db:"id"`
-	Name           string         `db:"name"`
-	AllowedDomains pq.StringArray `db:"allowed_domains"`
-	Description    *string        `db:"description"`
-	StartDate      time.Time      `db:"start_date"`
-	EndDate        time.Time      `db:"end_date"`
-	CreatedAt      time.Time      `db:"created_at"`
-	UpdatedAt      *time.Time     `db:"updated_at"`
-	Status         EventStatus    `db:"status"`
+	ID              string          `db:"id"`
+	Name            string          `db:"name"`
+	AllowedDomains  pq.StringArray  `db:"allowed_domains"`
+	Description     *string         `db:"description"`
+	StartDate       time.Time       `db:"start_date"`
+	EndDate         time.Time       `db:"end_date"`
+	CreatedAt       time.Time       `db:"created_at"`
+	UpdatedAt       *time.Time      `db:"updated_at"`
+	Status          EventStatus     `db:"status"`
+	CertificateMode CertificateMode `db:"certificate_mode"`
+	// regra de frequência mínima para emissão de certificados
+	AttendanceRule      AttendanceRule `db:"attendance_rule"`
+	AttendanceThreshold float64        `db:"attendance_threshold"`
+	// origem da carga horária dos certificados
+	WorkloadSource WorkloadSource `db:"workload_source"`
+	// quando ativo, o check-in exige inscrição prévia no evento
+	RegistrationRequired bool `db:"registration_required"`
+	// local do evento, usado como cerca geográfica no check-in
+	Venue
 }
 
 type NewEventParams struct {
@@ -37,6 +139,7 @@ type NewEventParams struct {
 	Description    *string
 	StartDate      time.Time
 	EndDate        time.Time
+	Venue          Venue
 }
 
 func NewEvent(params NewEventParams) (*Event, error) {
@@ -51,15 +154,21 @@ func NewEvent(params NewEventParams) (*Event, error) {
 	}
 
 	return &Event{
-		ID:             id,
-		Name:           params.Name,
-		AllowedDomains: domains,
-		Description:    params.Description,
-		StartDate:      params.StartDate,
-		EndDate:        params.EndDate,
-		CreatedAt:      time.Now(),
-		UpdatedAt:      nil,
-		Status:         EventStatusDraft,
+		ID:                   id,
+		Name:                 params.Name,
+		AllowedDomains:       domains,
+		Description:          params.Description,
+		StartDate:            params.StartDate,
+		EndDate:              params.EndDate,
+		CreatedAt:            time.Now(),
+		UpdatedAt:            nil,
+		Status:               EventStatusDraft,
+		CertificateMode:      CertificateModePerActivity,
+		AttendanceRule:       AttendanceRuleNone,
+		AttendanceThreshold:  0,
+		WorkloadSource:       WorkloadSourceScheduled,
+		RegistrationRequired: false,
+		Venue:                params.Venue,
 	}, nil
 }
 
@@ -68,6 +177,138 @@ func NewEvent(params NewEventParams) (*Event, error) {
 // 	e.UpdatedAt = &now
 // }
 
+// CanTransitionTo verifica se a máquina de estados permite ir para o status
+func (e *Event) CanTransitionTo(status EventStatus) bool {
+	for _, allowed := range eventTransitions[e.Status] {
+		if allowed == status {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionTo muda o status do evento respeitando as transições permitidas
+func (e *Event) TransitionTo(status EventStatus) error {
+	if !e.CanTransitionTo(status) {
+		return fmt.Errorf("%w: cannot change event from %s to %s", ErrInvalidStatusTransition, e.Status, status)
+	}
+	e.Status = status
+	return nil
+}
+
+// Publish abre o evento para check-ins
+func (e *Event) Publish() error {
+	return e.TransitionTo(EventStatusPublished)
+}
+
+// Cancel cancela um evento em rascunho ou publicado
+func (e *Event) Cancel() error {
+	return e.TransitionTo(EventStatusCancelled)
+}
+
+// Reopen volta um evento cancelado ou finalizado para publicado
+func (e *Event) Reopen() error {
+	if e.Status != EventStatusCancelled && e.Status != EventStatusCompleted {
+		return fmt.Errorf("%w: only cancelled or completed events can be reopened", ErrInvalidStatusTransition)
+	}
+	return e.TransitionTo(EventStatusPublished)
+}
+
+// Complete finaliza o evento publicado, após a emissão dos certificados
+func (e *Event) Complete() error {
+	return e.TransitionTo(EventStatusCompleted)
+}
+
+// IsEditable indica se o evento e suas atividades podem ser alterados ou removidos;
+// eventos finalizados já emitiram certificados e ficam congelados
+func (e *Event) IsEditable() bool {
+	return e.Status != EventStatusCompleted
+}
+
+// ContainsActivity verifica se o horário da atividade está dentro do período do evento
+func (e *Event) ContainsActivity(activity *Activity) bool {
+	return !activity.StartDate.Before(e.StartDate) && !activity.EndDate.After(e.EndDate)
+}
+
+// AcceptsRegistrations indica se o evento recebe inscrições: publicado e ainda não encerrado
+func (e *Event) AcceptsRegistrations(now time.Time) bool {
+	return e.Status == EventStatusPublished && now.Before(e.EndDate)
+}
+
+// AcceptsCheckIns indica se o evento recebe check-ins: apenas publicado
+// (rascunhos, cancelados e finalizados não recebem)
+func (e *Event) AcceptsCheckIns() bool {
+	return e.Status == EventStatusPublished
+}
+
+// verifica se o evento emite um certificado por atividade
+func (e *Event) IssuesPerActivityCertificates() bool {
+	return e.CertificateMode == CertificateModePerActivity || e.CertificateMode == CertificateModeBoth
+}
+
+// verifica se o evento emite um certificado consolidado por participante
+func (e *Event) IssuesConsolidatedCertificate() bool {
+	return e.CertificateMode == CertificateModeConsolidated || e.CertificateMode == CertificateModeBoth
+}
+
+// carga horária de um check-in conforme a origem configurada no evento
+func (e *Event) CheckInWorkload(activity *Activity, checkIn *CheckIn) time.Duration {
+	if e.WorkloadSource == WorkloadSourceAttended {
+		return checkIn.AttendedDuration(activity)
+	}
+	return activity.Workload()
+}
+
+// verifica se o participante atingiu a frequência mínima do evento
+// attended é o número de atividades com check-in, total o número de atividades do evento
+// e workload a carga horária somada das atividades com check-in
+func (e *Event) MeetsAttendanceRule(attended, total int, workload time.Duration) bool {
+	switch e.AttendanceRule {
+	case AttendanceRuleMinActivities:
+		return float64(attended) >= e.AttendanceThreshold
+	case AttendanceRuleMinPercentage:
+		if total == 0 {
+			return false
+		}
+		return float64(attended)/float64(total)*100 >= e.AttendanceThreshold
+	case AttendanceRuleMinHours:
+		return workload.Hours() >= e.AttendanceThreshold
+	default:
+		return true
+	}
+}
+
+// QualifiesForCertificate verifica a frequência mínima a partir dos check-ins do participante
+// activities são todas as atividades do evento; check-ins de outras atividades são ignorados
+func (e *Event) QualifiesForCertificate(activities []*Activity, checkIns []*CheckIn) bool {
+	activityIndex := make(map[string]*Activity, len(activities))
+	for _, activity := range activities {
+		activityIndex[activity.ID] = activity
+	}
+
+	attended := 0
+	var workload time.Duration
+	for _, checkIn := range checkIns {
+		activity, ok := activityIndex[checkIn.ActivityID]
+		if !ok {
+			continue
+		}
+		attended++
+		workload += e.CheckInWorkload(activity, checkIn)
+	}
+
+	return e.MeetsAttendanceRule(attended, len(activities), workload)
+}
+
+// CheckInVenue retorna o local que delimita o check-in da atividade:
+// o da própria atividade ou, se não houver, o do evento
+func (e *Event) CheckInVenue(activity *Activity) Venue {
+	if activity.HasVenue() {
+		return activity.Venue
+	}
+	return e.Venue
+}
+
 // verifica se o dominio passado é valido
 // se AllowedDomains for nulo ou vazio, permite todos os domínios
 func (e *Event) IsAllowedDomain(email string) bool {
@@ -75,7 +316,7 @@ func (e *Event) IsAllowedDomain(email string) bool {
 		return true
 	}
 
-	domain := extractDomain(email)
+	domain := EmailDomain(email)
 	for _, allowedDomain := range e.AllowedDomains {
 		if allowedDomain == domain {
 			return true
@@ -99,7 +340,8 @@ func (e *Event) IsEndDateAfterStartDate() bool {
 	return e.EndDate.After(e.StartDate)
 }
 
-func extractDomain(email string) string {
+// EmailDomain retorna o domínio do email, vazio se o email for inválido
+func EmailDomain(email string) string {
 	parts := strings.Split(email, "@")
 	if len(parts) != 2 {
 		return ""
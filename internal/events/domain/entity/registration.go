@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+)
+
+var (
+	ErrAlreadyRegistered      = errors.New("user already registered")
+	ErrRegistrationClosed     = errors.New("event is not open for registration")
+	ErrRegistrationRequired   = errors.New("registration required for check-in")
+	ErrRegistrationNotActive  = errors.New("registration already cancelled")
+	ErrRegistrationNotOffered = errors.New("registration has no spot to confirm")
+	ErrConfirmationExpired    = errors.New("confirmation deadline expired")
+)
+
+// Enum situação da inscrição
+type RegistrationStatus string
+
+const (
+	RegistrationStatusConfirmed RegistrationStatus = "confirmed"
+	// atividade lotada, aguardando uma vaga
+	RegistrationStatusWaitlisted RegistrationStatus = "waitlisted"
+	// vaga oferecida a quem estava na lista de espera, aguardando confirmação até o prazo
+	RegistrationStatusPendingConfirmation RegistrationStatus = "pending_confirmation"
+	RegistrationStatusCancelled           RegistrationStatus = "cancelled"
+	// a vaga oferecida não foi confirmada a tempo e passou para o próximo da fila
+	RegistrationStatusExpired RegistrationStatus = "expired"
+)
+
+// Registration é a inscrição de um participante no evento (ActivityID nulo) ou em uma atividade do evento
+type Registration struct {
+	ID          string             `db:"id"`
+	UserID      string             `db:"user_id"`
+	EventID     string             `db:"event_id"`
+	ActivityID  *string            `db:"activity_id"`
+	Status      RegistrationStatus `db:"status"`
+	CreatedAt   time.Time          `db:"created_at"`
+	CancelledAt *time.Time         `db:"cancelled_at"`
+	// preenchido quando uma vaga é oferecida a quem estava na lista de espera
+	ConfirmationDeadline *time.Time `db:"confirmation_deadline"`
+}
+
+type NewRegistrationParams struct {
+	UserID     string
+	EventID    string
+	ActivityID *string
+	// atividade lotada: a inscrição entra na lista de espera
+	Waitlisted bool
+}
+
+func NewRegistration(params NewRegistrationParams) (*Registration, error) {
+	id, err := lib.GenerateID(lib.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate registration ID: %w", err)
+	}
+
+	status := RegistrationStatusConfirmed
+	if params.Waitlisted {
+		status = RegistrationStatusWaitlisted
+	}
+
+	return &Registration{
+		ID:                   id,
+		UserID:               params.UserID,
+		EventID:              params.EventID,
+		ActivityID:           params.ActivityID,
+		Status:               status,
+		CreatedAt:            time.Now(),
+		CancelledAt:          nil,
+		ConfirmationDeadline: nil,
+	}, nil
+}
+
+// IsForActivity indica se a inscrição é em uma atividade, e não no evento
+func (r *Registration) IsForActivity() bool {
+	return r.ActivityID != nil
+}
+
+// IsActive indica se a inscrição ainda vale, ocupando ou aguardando uma vaga
+func (r *Registration) IsActive() bool {
+	return r.Status != RegistrationStatusCancelled && r.Status != RegistrationStatusExpired
+}
+
+// HoldsSeat indica se a inscrição ocupa uma vaga da atividade
+func (r *Registration) HoldsSeat() bool {
+	return r.Status == RegistrationStatusConfirmed || r.Status == RegistrationStatusPendingConfirmation
+}
+
+// Offer oferece a vaga a quem estava na lista de espera, com prazo para confirmar
+func (r *Registration) Offer(deadline time.Time) error {
+	if r.Status != RegistrationStatusWaitlisted {
+		return fmt.Errorf("registration is not waitlisted")
+	}
+	r.Status = RegistrationStatusPendingConfirmation
+	r.ConfirmationDeadline = &deadline
+	return nil
+}
+
+// Confirm confirma a vaga oferecida dentro do prazo
+func (r *Registration) Confirm(at time.Time) error {
+	if r.Status != RegistrationStatusPendingConfirmation {
+		return ErrRegistrationNotOffered
+	}
+	if r.ConfirmationDeadline != nil && at.After(*r.ConfirmationDeadline) {
+		return ErrConfirmationExpired
+	}
+	r.Status = RegistrationStatusConfirmed
+	return nil
+}
+
+func (r *Registration) Cancel(at time.Time) error {
+	if !r.IsActive() {
+		return ErrRegistrationNotActive
+	}
+	r.Status = RegistrationStatusCancelled
+	r.CancelledAt = &at
+	return nil
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Limites do raio da cerca geográfica (em metros)
+const (
+	MinVenueRadius = 10
+	MaxVenueRadius = 50000
+)
+
+// raio médio da Terra em metros
+const earthRadiusMeters = 6371000
+
+var (
+	ErrInvalidVenue            = errors.New("invalid venue")
+	ErrCheckInLocationRequired = errors.New("location required for check-in")
+	ErrCheckInOutsideVenue     = errors.New("check-in location outside the venue")
+)
+
+// Venue é o local de um evento ou atividade; com coordenadas e raio, funciona como
+// cerca geográfica (geofence) para o check-in
+type Venue struct {
+	VenueName         *string  `db:"venue_name"`
+	VenueLatitude     *float64 `db:"venue_latitude"`
+	VenueLongitude    *float64 `db:"venue_longitude"`
+	VenueRadiusMeters *int     `db:"venue_radius_meters"`
+}
+
+type NewVenueParams struct {
+	Name         *string
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters int
+}
+
+func NewVenue(params NewVenueParams) (Venue, error) {
+	if params.Latitude < -90 || params.Latitude > 90 {
+		return Venue{}, fmt.Errorf("%w: latitude must be between -90 and 90", ErrInvalidVenue)
+	}
+	if params.Longitude < -180 || params.Longitude > 180 {
+		return Venue{}, fmt.Errorf("%w: longitude must be between -180 and 180", ErrInvalidVenue)
+	}
+	if params.RadiusMeters < MinVenueRadius || params.RadiusMeters > MaxVenueRadius {
+		return Venue{}, fmt.Errorf("%w: radius must be between %d and %d meters", ErrInvalidVenue, MinVenueRadius, MaxVenueRadius)
+	}
+
+	return Venue{
+		VenueName:         params.Name,
+		VenueLatitude:     &params.Latitude,
+		VenueLongitude:    &params.Longitude,
+		VenueRadiusMeters: &params.RadiusMeters,
+	}, nil
+}
+
+// verifica se o local tem coordenadas e raio configurados
+func (v Venue) HasVenue() bool {
+	return v.VenueLatitude != nil && v.VenueLongitude != nil && v.VenueRadiusMeters != nil
+}
+
+// VenueDistance calcula a distância (em metros) entre o local e as coordenadas, pela fórmula de haversine
+func (v Venue) VenueDistance(latitude, longitude float64) float64 {
+	lat1 := *v.VenueLatitude * math.Pi / 180
+	lat2 := latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLng := (longitude - *v.VenueLongitude) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// verifica se as coordenadas estão dentro do raio do local
+func (v Venue) IsWithinVenue(latitude, longitude float64) bool {
+	return v.VenueDistance(latitude, longitude) <= float64(*v.VenueRadiusMeters)
+}
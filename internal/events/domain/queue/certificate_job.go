@@ -7,6 +7,14 @@ import (
 	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
 )
 
+// CertificateJobKind define se o job gera o certificado de uma atividade ou o consolidado do evento
+type CertificateJobKind string
+
+const (
+	CertificateJobKindActivity CertificateJobKind = "activity"
+	CertificateJobKindEvent    CertificateJobKind = "event"
+)
+
 type UserInfo struct {
 	UserID    string `json:"user_id"`
 	UserName  string `json:"user_name"`
@@ -19,21 +27,31 @@ type ActivityInfo struct {
 	ActivityDate time.Time `json:"activity_date"`
 	StartTime    time.Time `json:"start_time"`
 	EndTime      time.Time `json:"end_time"`
+	// WorkloadMinutes é o tempo efetivamente assistido; nil usa o horário programado
+	WorkloadMinutes *int `json:"workload_minutes,omitempty"`
 }
 
 type EventInfo struct {
-	EventID   string `json:"event_id"`
-	EventName string `json:"event_name"`
+	EventID   string    `json:"event_id"`
+	EventName string    `json:"event_name"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
 }
 
 // certificateJob é a struct privada - só pode ser criada via NewCertificateJob
 type certificateJob struct {
-	JobID        string       `json:"job_id"`
-	EventInfo    EventInfo    `json:"event_info"`
-	UserInfo     UserInfo     `json:"user_info"`
-	ActivityInfo ActivityInfo `json:"activity_info"`
-	CheckedAt    time.Time    `json:"checked_at"`
-	EnqueuedAt   time.Time    `json:"enqueued_at"`
+	JobID        string             `json:"job_id"`
+	Kind         CertificateJobKind `json:"kind,omitempty"`
+	EventInfo    EventInfo          `json:"event_info"`
+	UserInfo     UserInfo           `json:"user_info"`
+	ActivityInfo ActivityInfo       `json:"activity_info"`
+	// Activities contém as atividades do certificado consolidado (Kind == event)
+	Activities []ActivityInfo `json:"activities,omitempty"`
+	CheckedAt  time.Time      `json:"checked_at"`
+	EnqueuedAt time.Time      `json:"enqueued_at"`
+	// Attempts conta quantas vezes o processamento do job falhou
+	Attempts  int     `json:"attempts"`
+	LastError *string `json:"last_error,omitempty"`
 }
 
 // CertificateJob é o tipo público (alias) para uso externo
@@ -55,18 +73,83 @@ func NewCertificateJob(params NewCertificateJobParams) (*CertificateJob, error)
 
 	return &CertificateJob{
 		JobID:        id,
+		Kind:         CertificateJobKindActivity,
 		EventInfo:    params.EventInfo,
 		UserInfo:     params.UserInfo,
 		ActivityInfo: params.ActivityInfo,
+		Activities:   nil,
 		CheckedAt:    params.CheckedAt,
 		EnqueuedAt:   time.Now(),
+		Attempts:     0,
+		LastError:    nil,
+	}, nil
+}
+
+type NewEventCertificateJobParams struct {
+	EventInfo  EventInfo
+	UserInfo   UserInfo
+	Activities []ActivityInfo
+	// CheckedAt é o horário do último check-in do participante no evento
+	CheckedAt time.Time
+}
+
+// NewEventCertificateJob cria o job do certificado consolidado de um participante no evento
+func NewEventCertificateJob(params NewEventCertificateJobParams) (*CertificateJob, error) {
+	id, err := lib.GenerateID(lib.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate job ID: %w", err)
+	}
+
+	return &CertificateJob{
+		JobID:        id,
+		Kind:         CertificateJobKindEvent,
+		EventInfo:    params.EventInfo,
+		UserInfo:     params.UserInfo,
+		ActivityInfo: ActivityInfo{}, //nolint:exhaustruct
+		Activities:   params.Activities,
+		CheckedAt:    params.CheckedAt,
+		EnqueuedAt:   time.Now(),
+		Attempts:     0,
+		LastError:    nil,
 	}, nil
 }
 
 // Getters para acesso aos campos (opcional, mas útil para encapsulamento)
-func (j *CertificateJob) GetJobID() string        { return j.JobID }
-func (j *CertificateJob) GetEventInfo() EventInfo { return j.EventInfo }
-func (j *CertificateJob) GetUserInfo() UserInfo   { return j.UserInfo }
+func (j *CertificateJob) GetJobID() string              { return j.JobID }
+func (j *CertificateJob) GetEventInfo() EventInfo       { return j.EventInfo }
+func (j *CertificateJob) GetUserInfo() UserInfo         { return j.UserInfo }
 func (j *CertificateJob) GetActivityInfo() ActivityInfo { return j.ActivityInfo }
-func (j *CertificateJob) GetCheckedAt() time.Time { return j.CheckedAt }
-func (j *CertificateJob) GetEnqueuedAt() time.Time { return j.EnqueuedAt }
+func (j *CertificateJob) GetActivities() []ActivityInfo { return j.Activities }
+func (j *CertificateJob) GetCheckedAt() time.Time       { return j.CheckedAt }
+func (j *CertificateJob) GetEnqueuedAt() time.Time      { return j.EnqueuedAt }
+func (j *CertificateJob) GetAttempts() int              { return j.Attempts }
+
+// GetKind retorna o tipo do job; jobs antigos, sem tipo, são de atividade
+func (j *CertificateJob) GetKind() CertificateJobKind {
+	if j.Kind == "" {
+		return CertificateJobKindActivity
+	}
+	return j.Kind
+}
+
+// DedupKey identifica o certificado do job: o participante em uma atividade ou, no consolidado, no evento;
+// jobs com a mesma chave geram o mesmo certificado
+func (j *CertificateJob) DedupKey() string {
+	if j.GetKind() == CertificateJobKindEvent {
+		return fmt.Sprintf("event:%s:%s", j.UserInfo.UserID, j.EventInfo.EventID)
+	}
+	return fmt.Sprintf("activity:%s:%s", j.UserInfo.UserID, j.ActivityInfo.ActivityID)
+}
+
+// RecordFailure incrementa o contador de tentativas e guarda o último erro
+func (j *CertificateJob) RecordFailure(cause error) {
+	msg := cause.Error()
+	j.Attempts++
+	j.LastError = &msg
+}
+
+// ResetAttempts zera o contador de tentativas (usado no replay da dead-letter)
+func (j *CertificateJob) ResetAttempts() {
+	j.Attempts = 0
+	j.LastError = nil
+}
@@ -2,10 +2,23 @@ package queue
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+var (
+	ErrJobNotFound = errors.New("job not found")
+
+	// ErrLeaseLost indica que a reserva do job expirou e ele pode estar com outro worker
+	ErrLeaseLost = errors.New("job lease lost")
+)
+
 // CertificateQueue define a interface (Port) para a fila de certificados
+//
+// A entrega é at-least-once: o job retornado por Dequeue fica em processamento
+// até ser confirmado com Ack ou devolvido com Nack. Se o worker morrer antes
+// disso, o job volta para a fila quando o tempo de visibilidade expira; jobs
+// demorados devem renovar a reserva com ExtendLease enquanto são processados.
 type CertificateQueue interface {
 	// Enqueue adiciona um job na fila
 	Enqueue(ctx context.Context, job *CertificateJob) error
@@ -13,12 +26,49 @@ type CertificateQueue interface {
 	// EnqueueBatch adiciona múltiplos jobs na fila (mais eficiente)
 	EnqueueBatch(ctx context.Context, jobs []*CertificateJob) error
 
-	// Dequeue remove e retorna o próximo job da fila (blocking)
+	// Dequeue reserva e retorna o próximo job da fila (blocking)
 	Dequeue(ctx context.Context) (*CertificateJob, error)
 
-	// DequeueWithTimeout remove com timeout (retorna nil se timeout)
+	// DequeueWithTimeout reserva com timeout (retorna nil se timeout)
 	DequeueWithTimeout(ctx context.Context, timeout time.Duration) (*CertificateJob, error)
 
+	// ExtendLease renova o prazo da reserva do job por mais um tempo de visibilidade
+	// Retorna ErrLeaseLost se a reserva já expirou e o job foi recuperado pela fila
+	ExtendLease(ctx context.Context, job *CertificateJob) error
+
+	// Ack confirma que o job foi processado e o remove definitivamente
+	// Retorna ErrLeaseLost se o job não está mais reservado por quem chamou
+	Ack(ctx context.Context, job *CertificateJob) error
+
+	// Nack registra a falha do job: reagenda com backoff exponencial ou,
+	// se as tentativas se esgotaram, move para a dead-letter (deadLettered = true)
+	// Retorna ErrLeaseLost se o job não está mais reservado por quem chamou
+	Nack(ctx context.Context, job *CertificateJob, cause error) (deadLettered bool, err error)
+
+	// ListDeadLetters retorna até limit jobs da dead-letter
+	ListDeadLetters(ctx context.Context, limit int64) ([]*CertificateJob, error)
+
+	// ReplayDeadLetter devolve um job da dead-letter para a fila com as tentativas zeradas
+	ReplayDeadLetter(ctx context.Context, jobID string) (*CertificateJob, error)
+
+	// RemoveDeadLetters remove os jobs da dead-letter (ex: antes de reenviá-los por outro caminho);
+	// jobs que não estão na dead-letter são ignorados
+	RemoveDeadLetters(ctx context.Context, jobIDs []string) error
+
 	// Len retorna o tamanho atual da fila
 	Len(ctx context.Context) (int64, error)
+
+	// OnDeadLetter registra quem deve ser avisado quando a própria fila move um job para a dead-letter
+	// (reserva expirada vezes demais), sem passar por Nack; deve ser chamado antes de consumir a fila
+	OnDeadLetter(handler DeadLetterHandler)
+}
+
+// DeadLetterHandler recebe o job movido para a dead-letter e o motivo
+type DeadLetterHandler func(ctx context.Context, job *CertificateJob, cause error)
+
+// CertificateEnqueuer publica jobs na fila dentro de uma transação do banco
+// Só existe nas filas guardadas no próprio banco (driver postgres): o job entra na fila
+// se e somente se a transação fizer commit
+type CertificateEnqueuer interface {
+	EnqueueBatch(ctx context.Context, jobs []*CertificateJob) error
 }
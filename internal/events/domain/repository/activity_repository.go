@@ -14,10 +14,14 @@ type ActivityRepository interface {
 	Save(ctx context.Context, activity *entity.Activity) (*entity.Activity, error)
 	SaveAll(ctx context.Context, activities []*entity.Activity) ([]*entity.Activity, error)
 	FindByID(ctx context.Context, id string) (*entity.Activity, error)
+	// FindByIDForUpdate bloqueia a atividade até o fim da transação (controle de capacidade)
+	FindByIDForUpdate(ctx context.Context, id string) (*entity.Activity, error)
 	FindByEventID(ctx context.Context, eventID string) ([]*entity.Activity, error)
 	FindByEventIDAndNames(ctx context.Context, eventID string, names []string) ([]*entity.Activity, error)
 	FindAll(ctx context.Context) ([]*entity.Activity, error)
 	Update(ctx context.Context, activity *entity.Activity) (*entity.Activity, error)
+	UpdateCheckInSettings(ctx context.Context, activity *entity.Activity) (*entity.Activity, error)
 	Delete(ctx context.Context, id string) error
+	DeleteByEventID(ctx context.Context, eventID string) error
 	FindByActivityIDWithEvent(ctx context.Context, activityID string) (*ActivityWithEvent, error)
 }
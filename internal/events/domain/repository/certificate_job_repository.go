@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+)
+
+// CertificateJobStatus é a etapa do ciclo de vida de um job de certificado
+type CertificateJobStatus string
+
+const (
+	CertificateJobStatusQueued     CertificateJobStatus = "queued"
+	CertificateJobStatusGenerating CertificateJobStatus = "generating"
+	CertificateJobStatusSending    CertificateJobStatus = "sending"
+	CertificateJobStatusSent       CertificateJobStatus = "sent"
+	// a última tentativa falhou e o job está agendado para uma nova tentativa na fila
+	CertificateJobStatusRetrying CertificateJobStatus = "retrying"
+	// as tentativas se esgotaram e o job foi para a dead-letter
+	CertificateJobStatusFailed CertificateJobStatus = "failed"
+	// o certificado ou o check-in foi revogado e o job foi descartado sem enviar o email; não pode ser reenviado
+	CertificateJobStatusRevoked CertificateJobStatus = "revoked"
+)
+
+// CertificateJobStatuses lista os status na ordem do ciclo de vida
+var CertificateJobStatuses = []CertificateJobStatus{
+	CertificateJobStatusQueued,
+	CertificateJobStatusGenerating,
+	CertificateJobStatusSending,
+	CertificateJobStatusSent,
+	CertificateJobStatusRetrying,
+	CertificateJobStatusFailed,
+	CertificateJobStatusRevoked,
+}
+
+// certificateJobTerminalStatuses são os status em que a fila não tem mais cópia ativa do job
+var certificateJobTerminalStatuses = []CertificateJobStatus{
+	CertificateJobStatusSent,
+	CertificateJobStatusFailed,
+}
+
+func (s CertificateJobStatus) IsValid() bool {
+	for _, status := range CertificateJobStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminal indica que o job terminou (enviado ou na dead-letter) e pode ser reenviado
+func (s CertificateJobStatus) IsTerminal() bool {
+	for _, status := range certificateJobTerminalStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// CertificateJobTerminalStatuses retorna os status a partir dos quais o job pode ser reenviado
+func CertificateJobTerminalStatuses() []CertificateJobStatus {
+	return slices.Clone(certificateJobTerminalStatuses)
+}
+
+// CertificateJobRecord é o job gravado com o seu status
+type CertificateJobRecord struct {
+	Job          *queue.CertificateJob
+	EventID      string
+	Status       CertificateJobStatus
+	Attempts     int
+	LastError    *string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DispatchedAt *time.Time
+}
+
+// CertificateJobRepository guarda os jobs de certificado na mesma transação que os gera (transactional outbox)
+// e acompanha o seu processamento; o relay publica os jobs pendentes na fila e os marca como despachados
+type CertificateJobRepository interface {
+	// Add grava os jobs cuja DedupKey ainda não existe e retorna os que foram gravados
+	Add(ctx context.Context, eventID string, jobs []*queue.CertificateJob) ([]*queue.CertificateJob, error)
+	// ClaimPending bloqueia e retorna até limit jobs ainda não despachados, por ordem de criação;
+	// deve ser usado dentro de uma transação, os jobs bloqueados por outro relay são ignorados
+	ClaimPending(ctx context.Context, limit int) ([]*queue.CertificateJob, error)
+	MarkDispatched(ctx context.Context, jobIDs []string, at time.Time) error
+
+	FindByID(ctx context.Context, jobID string) (*CertificateJobRecord, error)
+	// FindByEventID lista os jobs do evento por participante; status nil retorna todos
+	FindByEventID(ctx context.Context, eventID string, status *CertificateJobStatus) ([]*CertificateJobRecord, error)
+	CountByStatus(ctx context.Context, eventID string) (map[CertificateJobStatus]int, error)
+	// UpdateStatus registra a etapa do job: generating conta uma nova tentativa,
+	// retrying, failed e revoked gravam lastError e sent limpa o erro da tentativa anterior
+	UpdateStatus(ctx context.Context, jobID string, status CertificateJobStatus, lastError *string) error
+	// TransitionStatus muda o status para to apenas se o status atual estiver em from;
+	// retorna false se o job não existe ou está em outro status
+	TransitionStatus(ctx context.Context, jobID string, to CertificateJobStatus, from ...CertificateJobStatus) (bool, error)
+	// Requeue volta para queued os jobs em status terminal e os libera para o relay publicar de novo;
+	// jobs ainda em andamento são ignorados. Retorna quantos foram reenviados
+	Requeue(ctx context.Context, jobIDs []string) (int64, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+)
+
+type CertificateRepository interface {
+	// Save persiste o certificado; se já existir um para o mesmo job, retorna o existente
+	Save(ctx context.Context, certificate *entity.Certificate) (*entity.Certificate, error)
+	FindByID(ctx context.Context, id string) (*entity.Certificate, error)
+	FindByJobID(ctx context.Context, jobID string) (*entity.Certificate, error)
+	FindByVerificationCode(ctx context.Context, code string) (*entity.Certificate, error)
+	FindByUserID(ctx context.Context, userID string) ([]*entity.Certificate, error)
+	// ExistsByEventID e ExistsByActivityID indicam se já foram emitidos certificados
+	ExistsByEventID(ctx context.Context, eventID string) (bool, error)
+	ExistsByActivityID(ctx context.Context, activityID string) (bool, error)
+	// SetStorageKey registra onde o PDF do certificado foi armazenado
+	SetStorageKey(ctx context.Context, id string, storageKey string) error
+	// RevokeByUserAndActivity revoga o certificado da atividade do participante; certificados já revogados são mantidos.
+	// Retorna quantos foram revogados
+	RevokeByUserAndActivity(ctx context.Context, userID, activityID string, revokedAt time.Time, reason string) (int64, error)
+	// RevokeConsolidated revoga o certificado consolidado do evento do participante; retorna quantos foram revogados
+	RevokeConsolidated(ctx context.Context, userID, eventID string, revokedAt time.Time, reason string) (int64, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+)
+
+type CertificateTemplateRepository interface {
+	FindByEventID(ctx context.Context, eventID string) (*entity.CertificateTemplate, error)
+	// Upsert cria ou substitui o template do evento
+	Upsert(ctx context.Context, template *entity.CertificateTemplate) (*entity.CertificateTemplate, error)
+	DeleteByEventID(ctx context.Context, eventID string) error
+}
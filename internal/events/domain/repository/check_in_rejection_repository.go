@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+)
+
+type CheckInRejectionRepository interface {
+	Save(ctx context.Context, rejection *entity.CheckInRejection) (*entity.CheckInRejection, error)
+	// FindByEventID retorna as recusas das atividades do evento, das mais recentes para as mais antigas
+	FindByEventID(ctx context.Context, eventID string) ([]*entity.CheckInRejection, error)
+}
@@ -2,16 +2,79 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
 )
 
 // retornar evento com as atividades
+// as buscas por usuário/atividade ignoram check-ins revogados; FindByID retorna qualquer check-in
 type CheckInRepository interface {
 	Save(ctx context.Context, checkIn *entity.CheckIn) (*entity.CheckIn, error)
 	FindByActivityIDs(ctx context.Context, activityIDs []string) ([]*entity.CheckIn, error)
 	FindByUserID(ctx context.Context, userID string) ([]*entity.CheckIn, error)
+	// FindPageByUserID retorna até filter.Limit check-ins do usuário com o contexto do evento, do mais recente para o mais antigo
+	FindPageByUserID(ctx context.Context, userID string, filter UserCheckInListFilter) ([]*UserCheckIn, error)
 	FindByActivityID(ctx context.Context, activityID string) ([]*entity.CheckIn, error)
 	FindByID(ctx context.Context, id string) (*entity.CheckIn, error)
 	FindByUserAndActivity(ctx context.Context, userID, activityID string) (*entity.CheckIn, error)
+	CheckOut(ctx context.Context, checkIn *entity.CheckIn) (*entity.CheckIn, error)
+	Revoke(ctx context.Context, checkIn *entity.CheckIn) (*entity.CheckIn, error)
+	// DeleteByActivityIDs remove todos os check-ins das atividades, inclusive os revogados
+	DeleteByActivityIDs(ctx context.Context, activityIDs []string) error
+}
+
+// UserCheckInListFilter representa os filtros e a paginação dos check-ins de um usuário
+type UserCheckInListFilter struct {
+	EventID *string
+	// After é o último check-in da página anterior
+	After *CheckInCursor
+	Limit int
+}
+
+// CheckInCursor identifica a posição de um check-in na ordenação (checked_at, id)
+type CheckInCursor struct {
+	CheckedAt time.Time
+	ID        string
+}
+
+// Query Results
+
+// CertificateStatus é a situação do certificado referente a um check-in
+type CertificateStatus string
+
+const (
+	// o evento ainda não foi finalizado
+	CertificateStatusNotAvailable CertificateStatus = "not_available"
+	// evento finalizado, certificado ainda não emitido (em geração ou presença insuficiente)
+	CertificateStatusPending CertificateStatus = "pending"
+	CertificateStatusIssued  CertificateStatus = "issued"
+	CertificateStatusRevoked CertificateStatus = "revoked"
+)
+
+// UserCheckIn é um check-in com a atividade, o evento e o certificado correspondentes
+type UserCheckIn struct {
+	entity.CheckIn
+	ActivityName      string             `db:"activity_name"`
+	ActivityStartDate time.Time          `db:"activity_start_date"`
+	ActivityEndDate   time.Time          `db:"activity_end_date"`
+	EventID           string             `db:"event_id"`
+	EventName         string             `db:"event_name"`
+	EventStatus       entity.EventStatus `db:"event_status"`
+	// certificado da atividade ou, na falta dele, o consolidado do evento
+	CertificateID        *string    `db:"certificate_id"`
+	CertificateRevokedAt *time.Time `db:"certificate_revoked_at"`
+}
+
+func (c *UserCheckIn) CertificateStatus() CertificateStatus {
+	switch {
+	case c.CertificateID != nil && c.CertificateRevokedAt != nil:
+		return CertificateStatusRevoked
+	case c.CertificateID != nil:
+		return CertificateStatusIssued
+	case c.EventStatus == entity.EventStatusCompleted:
+		return CertificateStatusPending
+	default:
+		return CertificateStatusNotAvailable
+	}
 }
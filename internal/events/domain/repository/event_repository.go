@@ -11,6 +11,8 @@ type EventRepository interface {
 	Save(ctx context.Context, event *entity.Event) (*entity.Event, error)
 	FindByID(ctx context.Context, id string) (*entity.Event, error)
 	FindAll(ctx context.Context) ([]*entity.Event, error)
+	// FindPage retorna até filter.Limit eventos, do mais recente para o mais antigo
+	FindPage(ctx context.Context, filter EventListFilter) ([]*entity.Event, error)
 	Update(ctx context.Context, event *entity.Event) (*entity.Event, error)
 	PartialUpdate(ctx context.Context, id string, input UpdateEventInput) (*entity.Event, error)
 	Delete(ctx context.Context, id string) error
@@ -19,12 +21,40 @@ type EventRepository interface {
 
 // UpdateEventInput representa os campos opcionais para atualização parcial
 type UpdateEventInput struct {
-	Name           *string
-	AllowedDomains *[]string
-	Description    *string
-	StartDate      *time.Time
-	EndDate        *time.Time
-	Status         *entity.EventStatus
+	Name                 *string
+	AllowedDomains       *[]string
+	Description          *string
+	StartDate            *time.Time
+	EndDate              *time.Time
+	Status               *entity.EventStatus
+	CertificateMode      *entity.CertificateMode
+	AttendanceRule       *entity.AttendanceRule
+	AttendanceThreshold  *float64
+	WorkloadSource       *entity.WorkloadSource
+	RegistrationRequired *bool
+	// Venue substitui o local inteiro; um Venue vazio remove o local
+	Venue *entity.Venue
+}
+
+// EventListFilter representa os filtros e a paginação da listagem de eventos
+type EventListFilter struct {
+	Statuses []entity.EventStatus
+	// eventos que acontecem, ao menos em parte, entre From e To
+	From *time.Time
+	To   *time.Time
+	// busca parcial pelo nome, sem diferenciar maiúsculas
+	Search string
+	// quando preenchido, apenas eventos abertos a esse domínio de email
+	EmailDomain *string
+	// After é o último evento da página anterior
+	After *EventCursor
+	Limit int
+}
+
+// EventCursor identifica a posição de um evento na ordenação (created_at, id)
+type EventCursor struct {
+	CreatedAt time.Time
+	ID        string
 }
 
 // Query Results
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+)
+
+// as buscas consideram apenas inscrições ativas (nem canceladas nem expiradas); FindByID retorna qualquer inscrição
+type RegistrationRepository interface {
+	Save(ctx context.Context, registration *entity.Registration) (*entity.Registration, error)
+	FindByID(ctx context.Context, id string) (*entity.Registration, error)
+	// FindByIDForUpdate busca a inscrição bloqueando a linha até o fim da transação
+	FindByIDForUpdate(ctx context.Context, id string) (*entity.Registration, error)
+	// FindByUserAndEvent retorna as inscrições do usuário no evento e nas atividades do evento
+	FindByUserAndEvent(ctx context.Context, userID, eventID string) ([]*entity.Registration, error)
+	// CountSeatsByActivityID conta as inscrições que ocupam vaga (confirmadas e aguardando confirmação)
+	CountSeatsByActivityID(ctx context.Context, activityID string) (int, error)
+	CountWaitlistedByActivityID(ctx context.Context, activityID string) (int, error)
+	// FindWaitlisted retorna os primeiros da lista de espera da atividade, por ordem de inscrição
+	FindWaitlisted(ctx context.Context, activityID string, limit int) ([]*entity.Registration, error)
+	// FindActivityIDsWithWaitlist retorna as atividades com lista de espera ou com vagas oferecidas vencidas em now
+	FindActivityIDsWithWaitlist(ctx context.Context, now time.Time) ([]string, error)
+	// UpdateStatus grava o status, o cancelamento e o prazo de confirmação
+	UpdateStatus(ctx context.Context, registration *entity.Registration) (*entity.Registration, error)
+	// ExpireOffers expira as vagas oferecidas na atividade não confirmadas até now
+	ExpireOffers(ctx context.Context, activityID string, now time.Time) (int64, error)
+	// CancelByUserAndEvent cancela todas as inscrições do usuário no evento e retorna quantas foram canceladas
+	CancelByUserAndEvent(ctx context.Context, userID, eventID string, at time.Time) (int64, error)
+}
@@ -1,12 +1,22 @@
 package repository
 
-import "context"
+import (
+	"context"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+)
 
 // Repositories agrupa todos os repositórios disponíveis dentro de uma transação
 type Repositories struct {
-	Events     EventRepository
-	Activities ActivityRepository
-	CheckIns   CheckInRepository
+	Events          EventRepository
+	Activities      ActivityRepository
+	CheckIns        CheckInRepository
+	Registrations   RegistrationRepository
+	CertificateJobs CertificateJobRepository
+	Certificates    CertificateRepository
+	// CertificateQueue publica os jobs na fila dentro da transação; nil quando a fila
+	// não fica no banco (driver redis) e os jobs são publicados pelo relay do outbox
+	CertificateQueue queue.CertificateEnqueuer
 }
 
 // TransactionProvider gerencia transações de banco de dados
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+)
+
+// AttendeeNotifier avisa os participantes de um evento sobre mudanças nele
+type AttendeeNotifier interface {
+	// NotifyEventCancelled retorna quantos avisos foram enviados; os que falharam vêm no erro
+	NotifyEventCancelled(ctx context.Context, event *entity.Event, reason string, attendees []*UserInfo) (int, error)
+	// NotifyWaitlistPromotion avisa quem saiu da lista de espera que há uma vaga a confirmar até o prazo
+	NotifyWaitlistPromotion(ctx context.Context, event *entity.Event, activity *entity.Activity, deadline time.Time, attendees []*UserInfo) (int, error)
+}
@@ -0,0 +1,15 @@
+package service
+
+import "time"
+
+// CheckInToken é o token rotativo exibido no QR code da atividade
+type CheckInToken struct {
+	Value     string
+	ExpiresAt time.Time
+}
+
+// CheckInTokenService gera e valida tokens de check-in que mudam a cada período
+type CheckInTokenService interface {
+	Generate(activityID string, period time.Duration, at time.Time) CheckInToken
+	Verify(activityID, token string, period time.Duration, at time.Time) bool
+}
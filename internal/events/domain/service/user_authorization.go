@@ -15,4 +15,6 @@ type UserAuthorizationService interface {
 	IsUserAdmin(ctx context.Context, userID string) (bool, error)
 	GetUserEmail(ctx context.Context, userID string) (string, error)
 	GetUserInfoBatch(ctx context.Context, userIDs []string) ([]*UserInfo, error)
+	FindUserByEmail(ctx context.Context, email string) (*UserInfo, error)
+	FindUserByCPF(ctx context.Context, cpf string) (*UserInfo, error)
 }
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	cancelevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/cancel_event"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Request DTOs
+type CancelEventRequest struct {
+	Reason string `json:"reason,omitempty" validate:"max=500"`
+}
+
+// Response DTOs
+type CancelEventResponse struct {
+	Event     EventStatusResponse `json:"event"`
+	Attendees int                 `json:"attendees"`
+}
+
+// Handler
+type CancelEventHandler struct {
+	useCase *cancelevent.UseCase
+}
+
+func NewCancelEventHandler(uc *cancelevent.UseCase) *CancelEventHandler {
+	return &CancelEventHandler{useCase: uc}
+}
+
+// Handle cancels an event.
+// @Summary      Cancel event
+// @Description  Cancels a draft or published event, blocking new check-ins. Attendees with check-ins are notified by email, with the optional reason; the emails are sent in the background after the response, and attendees is how many will be notified. Admin only.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Param        event_id  path      string              true   "Event ID"
+// @Param        request   body      CancelEventRequest  false  "Cancellation reason"
+// @Success      200   {object}  CancelEventResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid request body"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Event already cancelled or completed"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/cancel [post]
+func (h *CancelEventHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	// o corpo é opcional
+	var req CancelEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input := &cancelevent.Input{
+		UserID:  middleware.GetUserID(r.Context()),
+		EventID: chi.URLParam(r, "event_id"),
+		Reason:  req.Reason,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		respondEventStatusError(w, err)
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, CancelEventResponse{
+		Event:     eventToStatusResponse(output.Event),
+		Attendees: output.Attendees,
+	})
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	cancelregistration "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/cancel_registration"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler
+type CancelRegistrationHandler struct {
+	useCase *cancelregistration.UseCase
+}
+
+func NewCancelRegistrationHandler(uc *cancelregistration.UseCase) *CancelRegistrationHandler {
+	return &CancelRegistrationHandler{useCase: uc}
+}
+
+// Handle cancels a registration.
+// @Summary      Cancel registration
+// @Description  Cancels a registration of the authenticated user. Cancelling the event registration also cancels the registrations in its activities. Freed seats are offered to the next people on the waitlist. Admins can cancel any registration.
+// @Tags         Registrations
+// @Produce      json
+// @Param        event_id         path      string  true  "Event ID"
+// @Param        registration_id  path      string  true  "Registration ID"
+// @Success      200   {object}  RegistrationResponse
+// @Failure      404   {object}  lib.ErrorResponse  "Registration not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Registration already cancelled"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/registrations/{registration_id} [delete]
+func (h *CancelRegistrationHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &cancelregistration.Input{
+		UserID:         middleware.GetUserID(r.Context()),
+		EventID:        chi.URLParam(r, "event_id"),
+		RegistrationID: chi.URLParam(r, "registration_id"),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		respondRegistrationError(w, err)
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, registrationToResponse(output.Registration))
+}
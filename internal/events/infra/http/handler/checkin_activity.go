@@ -1,15 +1,26 @@
 package handler
 
 import (
+	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"time"
 
 	checkinactivity "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/checkin_activity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
 	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
 	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
 	"github.com/go-chi/chi/v5"
 )
 
+// Request DTOs
+type CheckInActivityRequest struct {
+	Token     *string  `json:"token,omitempty"`
+	Latitude  *float64 `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,latitude"`
+	Longitude *float64 `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,longitude"`
+}
+
 // Response DTOs
 type CheckInActivityResponse struct {
 	ID         string    `json:"id"`
@@ -29,31 +40,60 @@ func NewCheckInActivityHandler(uc *checkinactivity.UseCase) *CheckInActivityHand
 
 // Handle performs a check-in to an activity.
 // @Summary      Check-in to activity
-// @Description  Performs a check-in to an activity. User must be authenticated.
+// @Description  Performs a check-in to an activity. User must be authenticated. When the activity requires in-person check-in, the rotating token shown on the activity QR code must be sent in the body or in the token query parameter. When the activity (or its event) has a venue, the body must include the participant latitude/longitude and the check-in is rejected outside the venue radius. Events that require registration only accept registered participants, and activities with limited capacity only accept participants registered in the activity.
 // @Tags         CheckIn
+// @Accept       json
 // @Produce      json
-// @Param        activity_id  path      string  true  "Activity ID"
+// @Param        activity_id  path      string                  true   "Activity ID"
+// @Param        token        query     string                  false  "Rotating check-in token"
+// @Param        request      body      CheckInActivityRequest  false  "Check-in data"
+// @Param        Idempotency-Key  header  string  false  "Replays the stored response when the same key is sent again"
 // @Success      201   {object}  CheckInActivityResponse
-// @Failure      400   {object}  lib.ErrorResponse  "Already checked in or outside activity time"
+// @Failure      400   {object}  lib.ErrorResponse  "Already checked in"
+// @Failure      403   {object}  lib.ErrorResponse  "Missing or invalid check-in token, missing location, outside the venue or not registered"
 // @Failure      404   {object}  lib.ErrorResponse  "Activity not found"
+// @Failure      422   {object}  lib.ErrorResponse  "Outside the activity check-in window or event not open for check-in"
 // @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
 // @Router       /activities/{activity_id}/checkin [post]
 func (h *CheckInActivityHandler) Handle(w http.ResponseWriter, r *http.Request) {
 	activityID := chi.URLParam(r, "activity_id")
 	userID := middleware.GetUserID(r.Context())
 
-	input := &checkinactivity.Input{
-		UserID:     userID,
-		ActivityID: activityID,
+	// o corpo é opcional
+	var req CheckInActivityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
+	input := checkInActivityRequestToInput(&req, userID, activityID, r.URL.Query().Get("token"))
+
 	output, err := h.useCase.Execute(r.Context(), input)
 	if err != nil {
+		if errors.Is(err, entity.ErrCheckInOutsideWindow) || errors.Is(err, entity.ErrEventNotOpenForCheckIn) {
+			lib.RespondError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		if errors.Is(err, entity.ErrAlreadyCheckedIn) {
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, entity.ErrCheckInLocationRequired) || errors.Is(err, entity.ErrCheckInOutsideVenue) ||
+			errors.Is(err, entity.ErrRegistrationRequired) {
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+			return
+		}
+
 		switch err.Error() {
 		case "activity not found":
 			lib.RespondError(w, http.StatusNotFound, err.Error())
-		case "user already checked in", "check-in not allowed outside activity time":
-			lib.RespondError(w, http.StatusBadRequest, err.Error())
+		case "check-in token required", "invalid check-in token":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
 		default:
 			lib.RespondError(w, http.StatusInternalServerError, err.Error())
 		}
@@ -69,3 +109,23 @@ func (h *CheckInActivityHandler) Handle(w http.ResponseWriter, r *http.Request)
 
 	lib.RespondJSON(w, http.StatusCreated, resp)
 }
+
+// Mappers
+func checkInActivityRequestToInput(req *CheckInActivityRequest, userID, activityID, queryToken string) *checkinactivity.Input {
+	token := queryToken
+	if req.Token != nil {
+		token = *req.Token
+	}
+
+	return &checkinactivity.Input{
+		UserID:     userID,
+		ActivityID: activityID,
+		Token:      token,
+		Latitude:   req.Latitude,
+		Longitude:  req.Longitude,
+
+		RegisteredBy:       "",
+		Reason:             "",
+		OverrideTimeWindow: false,
+	}
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	checkoutactivity "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/checkout_activity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Response DTOs
+type CheckOutActivityResponse struct {
+	ID              string    `json:"id"`
+	UserID          string    `json:"user_id"`
+	ActivityID      string    `json:"activity_id"`
+	CheckedAt       time.Time `json:"checked_at"`
+	CheckedOutAt    time.Time `json:"checked_out_at"`
+	AttendedMinutes int       `json:"attended_minutes"`
+}
+
+// Handler
+type CheckOutActivityHandler struct {
+	useCase *checkoutactivity.UseCase
+}
+
+func NewCheckOutActivityHandler(uc *checkoutactivity.UseCase) *CheckOutActivityHandler {
+	return &CheckOutActivityHandler{useCase: uc}
+}
+
+// Handle performs a check-out from an activity.
+// @Summary      Check-out from activity
+// @Description  Registers the check-out of the authenticated user from an activity and stores the attended time (limited to the activity schedule). Requires a previous check-in.
+// @Tags         CheckIn
+// @Produce      json
+// @Param        activity_id  path      string  true  "Activity ID"
+// @Success      200   {object}  CheckOutActivityResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Already checked out"
+// @Failure      404   {object}  lib.ErrorResponse  "Activity or check-in not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /activities/{activity_id}/checkout [post]
+func (h *CheckOutActivityHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	activityID := chi.URLParam(r, "activity_id")
+	userID := middleware.GetUserID(r.Context())
+
+	input := &checkoutactivity.Input{
+		UserID:     userID,
+		ActivityID: activityID,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		switch err.Error() {
+		case "activity not found", "check-in not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		case "user already checked out", "check-out before check-in":
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	resp := &CheckOutActivityResponse{
+		ID:              output.CheckIn.ID,
+		UserID:          output.CheckIn.UserID,
+		ActivityID:      output.CheckIn.ActivityID,
+		CheckedAt:       output.CheckIn.CheckedAt,
+		CheckedOutAt:    *output.CheckIn.CheckedOutAt,
+		AttendedMinutes: *output.CheckIn.AttendedMinutes,
+	}
+
+	lib.RespondJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	confirmregistration "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/confirm_registration"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler
+type ConfirmRegistrationHandler struct {
+	useCase *confirmregistration.UseCase
+}
+
+func NewConfirmRegistrationHandler(uc *confirmregistration.UseCase) *ConfirmRegistrationHandler {
+	return &ConfirmRegistrationHandler{useCase: uc}
+}
+
+// Handle confirms a spot offered from the waitlist.
+// @Summary      Confirm registration
+// @Description  Confirms the activity spot offered to the authenticated user after leaving the waitlist (status pending_confirmation). The spot must be confirmed before the confirmation deadline, otherwise it passes to the next person in line.
+// @Tags         Registrations
+// @Produce      json
+// @Param        event_id         path      string  true  "Event ID"
+// @Param        registration_id  path      string  true  "Registration ID"
+// @Success      200   {object}  RegistrationResponse
+// @Failure      404   {object}  lib.ErrorResponse  "Registration not found"
+// @Failure      409   {object}  lib.ErrorResponse  "No spot to confirm or confirmation deadline expired"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/registrations/{registration_id}/confirm [post]
+func (h *ConfirmRegistrationHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &confirmregistration.Input{
+		UserID:         middleware.GetUserID(r.Context()),
+		EventID:        chi.URLParam(r, "event_id"),
+		RegistrationID: chi.URLParam(r, "registration_id"),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		respondRegistrationError(w, err)
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, registrationToResponse(output.Registration))
+}
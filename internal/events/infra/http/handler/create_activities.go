@@ -2,6 +2,7 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
@@ -13,10 +14,13 @@ import (
 )
 
 type CreateActivityItem struct {
-	Name        string    `json:"name" validate:"required"`
-	Description *string   `json:"description"`
-	StartDate   time.Time `json:"start_date" validate:"required"`
-	EndDate     time.Time `json:"end_date" validate:"required,gtfield=StartDate"`
+	Name        string        `json:"name" validate:"required"`
+	Description *string       `json:"description"`
+	StartDate   time.Time     `json:"start_date" validate:"required"`
+	EndDate     time.Time     `json:"end_date" validate:"required,gtfield=StartDate"`
+	Track       *string       `json:"track,omitempty" validate:"omitempty,max=100"`
+	Capacity    *int          `json:"capacity,omitempty" validate:"omitempty,min=1"`
+	Venue       *VenueRequest `json:"venue,omitempty" validate:"omitempty"`
 }
 
 type CreateActivitiesRequest struct {
@@ -25,14 +29,29 @@ type CreateActivitiesRequest struct {
 }
 
 type CreateActivityResponse struct {
-	ID          string     `json:"id"`
-	Name        string     `json:"name"`
-	EventID     string     `json:"event_id"`
-	Description *string    `json:"description,omitempty"`
-	StartDate   time.Time  `json:"start_date"`
-	EndDate     time.Time  `json:"end_date"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	EventID     string         `json:"event_id"`
+	Description *string        `json:"description,omitempty"`
+	StartDate   time.Time      `json:"start_date"`
+	EndDate     time.Time      `json:"end_date"`
+	Track       *string        `json:"track,omitempty"`
+	Capacity    *int           `json:"capacity,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   *time.Time     `json:"updated_at,omitempty"`
+	Venue       *VenueResponse `json:"venue,omitempty"`
+}
+
+// ActivityValidationErrorResponse lista os problemas de uma atividade do lote
+type ActivityValidationErrorResponse struct {
+	Index  int      `json:"index"`
+	Name   string   `json:"name"`
+	Errors []string `json:"errors"`
+}
+
+type CreateActivitiesErrorResponse struct {
+	Error      string                            `json:"error"`
+	Activities []ActivityValidationErrorResponse `json:"activities"`
 }
 
 type CreateActivitiesHandler struct {
@@ -46,13 +65,16 @@ func NewCreateActivitiesHandler(logger *zap.Logger, uc *createactivities.UseCase
 
 // Handle creates activities for an event.
 // @Summary      Create activities
-// @Description  Creates one or more activities for an event. User must be authenticated.
+// @Description  Creates one or more activities for an event. Each activity must start before it ends and fall within the event dates, and activities in the same track cannot overlap. When any activity is invalid, nothing is created and the response lists the problems of each one. Admin only.
 // @Tags         Activities
 // @Accept       json
 // @Produce      json
 // @Param        request  body      CreateActivitiesRequest  true  "Activities to create"
 // @Success      201      {array}   CreateActivityResponse
 // @Failure      400      {object}  lib.ErrorResponse  "Invalid request body or validation error"
+// @Failure      403      {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404      {object}  lib.ErrorResponse  "Event not found"
+// @Failure      422      {object}  CreateActivitiesErrorResponse  "Invalid activity dates or overlapping tracks"
 // @Failure      500      {object}  lib.ErrorResponse  "Internal server error"
 // @Router       /events/activities [post]
 func (h *CreateActivitiesHandler) Handle(w http.ResponseWriter, r *http.Request) {
@@ -81,7 +103,23 @@ func (h *CreateActivitiesHandler) Handle(w http.ResponseWriter, r *http.Request)
 
 	output, err := h.useCase.Execute(r.Context(), input)
 	if err != nil {
-		lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		var batchErr *entity.ActivityBatchError
+		if errors.As(err, &batchErr) {
+			lib.RespondJSON(w, http.StatusUnprocessableEntity, activityBatchErrorToResponse(batchErr))
+			return
+		}
+		if errors.Is(err, entity.ErrInvalidVenue) {
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		switch err.Error() {
+		case "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case "event not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
 		return
 	}
 
@@ -100,6 +138,9 @@ func activityItemsToActivityInputs(items []CreateActivityItem) []createactivitie
 			Description: item.Description,
 			StartDate:   item.StartDate,
 			EndDate:     item.EndDate,
+			Track:       item.Track,
+			Capacity:    item.Capacity,
+			Venue:       venueRequestToParams(item.Venue),
 		}
 	}
 	return inputs
@@ -123,9 +164,31 @@ func activitiesToCreateActivitiesResponse(activities []*entity.Activity) []Creat
 			Description: activity.Description,
 			StartDate:   activity.StartDate,
 			EndDate:     activity.EndDate,
+			Track:       activity.Track,
+			Capacity:    activity.Capacity,
 			CreatedAt:   activity.CreatedAt,
 			UpdatedAt:   activity.UpdatedAt,
+			Venue:       venueToResponse(activity.Venue),
 		}
 	}
 	return responses
 }
+
+func activityBatchErrorToResponse(batchErr *entity.ActivityBatchError) CreateActivitiesErrorResponse {
+	activities := make([]ActivityValidationErrorResponse, len(batchErr.Activities))
+	for i, a := range batchErr.Activities {
+		messages := make([]string, len(a.Errors))
+		for j, err := range a.Errors {
+			messages[j] = err.Error()
+		}
+		activities[i] = ActivityValidationErrorResponse{
+			Index:  a.Index,
+			Name:   a.Name,
+			Errors: messages,
+		}
+	}
+	return CreateActivitiesErrorResponse{
+		Error:      batchErr.Error(),
+		Activities: activities,
+	}
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
@@ -14,23 +15,25 @@ import (
 
 // Request DTOs
 type CreateEventRequest struct {
-	Name           string    `json:"name" validate:"required,min=3,max=255"`
-	AllowedDomains []string  `json:"allowed_domains" validate:"dive,fqdn"`
-	Description    *string   `json:"description" validate:"omitempty,max=500"`
-	StartDate      time.Time `json:"start_date" validate:"required"`
-	EndDate        time.Time `json:"end_date" validate:"required,gtfield=StartDate"`
+	Name           string        `json:"name" validate:"required,min=3,max=255"`
+	AllowedDomains []string      `json:"allowed_domains" validate:"dive,fqdn"`
+	Description    *string       `json:"description" validate:"omitempty,max=500"`
+	StartDate      time.Time     `json:"start_date" validate:"required"`
+	EndDate        time.Time     `json:"end_date" validate:"required,gtfield=StartDate"`
+	Venue          *VenueRequest `json:"venue,omitempty" validate:"omitempty"`
 }
 
 // Response DTOs
 type CreateEventResponse struct {
-	ID             string     `json:"id"`
-	Name           string     `json:"name"`
-	AllowedDomains []string   `json:"allowed_domains"`
-	Description    *string    `json:"description,omitempty"`
-	StartDate      time.Time  `json:"start_date"`
-	EndDate        time.Time  `json:"end_date"`
-	CreatedAt      time.Time  `json:"created_at"`
-	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+	ID             string         `json:"id"`
+	Name           string         `json:"name"`
+	AllowedDomains []string       `json:"allowed_domains"`
+	Description    *string        `json:"description,omitempty"`
+	StartDate      time.Time      `json:"start_date"`
+	EndDate        time.Time      `json:"end_date"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      *time.Time     `json:"updated_at,omitempty"`
+	Venue          *VenueResponse `json:"venue,omitempty"`
 }
 
 // Handler
@@ -87,6 +90,10 @@ func (h *CreateEventHandler) Handle(w http.ResponseWriter, r *http.Request) {
 			lib.RespondError(w, http.StatusForbidden, err.Error())
 			return
 		}
+		if errors.Is(err, entity.ErrInvalidVenue) {
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		lib.RespondError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -104,6 +111,7 @@ func createEventRequestToInput(req *CreateEventRequest, userID string) *createev
 		Description:    req.Description,
 		StartDate:      req.StartDate,
 		EndDate:        req.EndDate,
+		Venue:          venueRequestToParams(req.Venue),
 	}
 }
 
@@ -117,5 +125,6 @@ func eventToCreateEventResponse(event *entity.Event) CreateEventResponse {
 		EndDate:        event.EndDate,
 		CreatedAt:      event.CreatedAt,
 		UpdatedAt:      event.UpdatedAt,
+		Venue:          venueToResponse(event.Venue),
 	}
 }
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net/http"
+
+	deleteactivity "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/delete_activity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler
+type DeleteActivityHandler struct {
+	useCase *deleteactivity.UseCase
+}
+
+func NewDeleteActivityHandler(uc *deleteactivity.UseCase) *DeleteActivityHandler {
+	return &DeleteActivityHandler{useCase: uc}
+}
+
+// Handle deletes an activity.
+// @Summary      Delete activity
+// @Description  Deletes an activity. Activities with check-ins are only deleted with force=true, which also deletes the check-ins. Activities of completed events and activities with issued certificates cannot be deleted. Admin only.
+// @Tags         Activities
+// @Param        activity_id  path   string  true   "Activity ID"
+// @Param        force        query  bool    false  "Also delete the check-ins"
+// @Success      204
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid force parameter"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Activity not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Event is completed, activity has check-ins or issued certificates"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /activities/{activity_id} [delete]
+func (h *DeleteActivityHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	force, err := parseForceParam(r)
+	if err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input := &deleteactivity.Input{
+		UserID:     middleware.GetUserID(r.Context()),
+		ActivityID: chi.URLParam(r, "activity_id"),
+		Force:      force,
+	}
+
+	if err := h.useCase.Execute(r.Context(), input); err != nil {
+		respondEventChangeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
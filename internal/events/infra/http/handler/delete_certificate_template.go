@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	deletecertificatetemplate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/delete_certificate_template"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler
+type DeleteCertificateTemplateHandler struct {
+	useCase *deletecertificatetemplate.UseCase
+}
+
+func NewDeleteCertificateTemplateHandler(uc *deletecertificatetemplate.UseCase) *DeleteCertificateTemplateHandler {
+	return &DeleteCertificateTemplateHandler{useCase: uc}
+}
+
+// Handle deletes the certificate template of an event.
+// @Summary      Delete certificate template
+// @Description  Deletes the certificate template of an event and its images. The event goes back to the default template. Admin only.
+// @Tags         Certificates
+// @Param        event_id  path      string  true  "Event ID"
+// @Success      204   "Template deleted"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Certificate template not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/certificate-template [delete]
+func (h *DeleteCertificateTemplateHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &deletecertificatetemplate.Input{
+		UserID:  middleware.GetUserID(r.Context()),
+		EventID: chi.URLParam(r, "event_id"),
+	}
+
+	if err := h.useCase.Execute(r.Context(), input); err != nil {
+		switch err.Error() {
+		case "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case "certificate template not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
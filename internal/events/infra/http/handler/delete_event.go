@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	deleteevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/delete_event"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler
+type DeleteEventHandler struct {
+	useCase *deleteevent.UseCase
+}
+
+func NewDeleteEventHandler(uc *deleteevent.UseCase) *DeleteEventHandler {
+	return &DeleteEventHandler{useCase: uc}
+}
+
+// Handle deletes an event with its activities.
+// @Summary      Delete event
+// @Description  Deletes an event and its activities. Events with check-ins are only deleted with force=true, which also deletes the check-ins. Completed events and events with issued certificates cannot be deleted. Admin only.
+// @Tags         Events
+// @Param        event_id  path   string  true   "Event ID"
+// @Param        force     query  bool    false  "Also delete the check-ins"
+// @Success      204
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid force parameter"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Event is completed, has check-ins or issued certificates"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id} [delete]
+func (h *DeleteEventHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	force, err := parseForceParam(r)
+	if err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input := &deleteevent.Input{
+		UserID:  middleware.GetUserID(r.Context()),
+		EventID: chi.URLParam(r, "event_id"),
+		Force:   force,
+	}
+
+	if err := h.useCase.Execute(r.Context(), input); err != nil {
+		respondEventChangeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseForceParam lê o parâmetro opcional force da query
+func parseForceParam(r *http.Request) (bool, error) {
+	raw := r.URL.Query().Get("force")
+	if raw == "" {
+		return false, nil
+	}
+
+	force, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("force must be a boolean")
+	}
+	return force, nil
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	downloadcertificate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/download_certificate"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler
+type DownloadCertificateHandler struct {
+	useCase *downloadcertificate.UseCase
+}
+
+func NewDownloadCertificateHandler(uc *downloadcertificate.UseCase) *DownloadCertificateHandler {
+	return &DownloadCertificateHandler{useCase: uc}
+}
+
+// Handle downloads the PDF of a certificate.
+// @Summary      Download certificate PDF
+// @Description  Downloads the stored PDF of a certificate. Only the certificate owner or an admin can download it; revoked certificates are no longer available.
+// @Tags         Certificates
+// @Produce      application/pdf
+// @Param        certificate_id  path      string  true  "Certificate ID"
+// @Success      200   {file}    file
+// @Failure      404   {object}  lib.ErrorResponse  "Certificate or file not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /certificates/{certificate_id}/pdf [get]
+func (h *DownloadCertificateHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &downloadcertificate.Input{
+		CertificateID: chi.URLParam(r, "certificate_id"),
+		UserID:        middleware.GetUserID(r.Context()),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		switch err.Error() {
+		case "certificate not found", "certificate file not available":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondFile(w, "application/pdf", output.Filename, output.Content)
+}
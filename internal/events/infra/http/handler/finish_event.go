@@ -1,15 +1,37 @@
 package handler
 
 import (
+	"errors"
 	"log"
 	"net/http"
 
 	finishevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/finish_event"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
 	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
 	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
 	"github.com/go-chi/chi/v5"
 )
 
+// Response DTOs
+type FinishEventResponse struct {
+	Message string `json:"message"`
+	// certificados novos agendados nesta chamada
+	EnqueuedJobs int `json:"enqueued_jobs"`
+	// certificados já agendados por uma finalização anterior
+	ExistingCertificates int                        `json:"existing_certificates"`
+	QualifiedUsers       int                        `json:"qualified_users"`
+	NotQualified         []NotQualifiedUserResponse `json:"not_qualified"`
+}
+
+type NotQualifiedUserResponse struct {
+	UserID             string `json:"user_id"`
+	Name               string `json:"name"`
+	Email              string `json:"email"`
+	AttendedActivities int    `json:"attended_activities"`
+	AttendedMinutes    int    `json:"attended_minutes"`
+}
+
+// Handler
 type FinishEventHandler struct {
 	useCase *finishevent.UseCase
 }
@@ -20,14 +42,15 @@ func NewFinishEventHandler(uc *finishevent.UseCase) *FinishEventHandler {
 
 // Handle finishes an event and enqueues certificate jobs.
 // @Summary      Finish event
-// @Description  Finishes an event and enqueues certificate generation jobs for all check-ins. Admin only.
+// @Description  Finishes an event and schedules certificate generation jobs for all check-ins, according to the event certificate mode (one per activity, one consolidated per participant, or both). Participants below the event attendance rule do not receive certificates and are listed in not_qualified. Only published events can be finished. The operation is idempotent: finishing an already completed event again only schedules the certificates that do not exist yet (one per participant and activity, or per participant in the event for the consolidated one), e.g. after late manual check-ins. enqueued_jobs counts the new certificates and existing_certificates the ones from previous runs. The jobs are stored together with the event status change and published to the certificate queue by the worker shortly after; their progress is listed in GET /events/{event_id}/certificates/jobs. Admin only.
 // @Tags         Events
 // @Produce      json
 // @Param        event_id  path      string  true  "Event ID"
-// @Success      202   {object}  map[string]string  "Jobs enqueued"
+// @Success      202   {object}  FinishEventResponse  "Jobs enqueued"
 // @Failure      400   {object}  lib.ErrorResponse  "Activities not ended or no check-ins"
 // @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
 // @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Event is not published or completed"
 // @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
 // @Router       /events/{event_id}/finish [post]
 func (h *FinishEventHandler) Handle(w http.ResponseWriter, r *http.Request) {
@@ -41,9 +64,14 @@ func (h *FinishEventHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		UserID:  userID,
 	}
 
-	err := h.useCase.Execute(r.Context(), input)
+	output, err := h.useCase.Execute(r.Context(), input)
 	if err != nil {
 		log.Printf("[finish_event] error=%q", err.Error())
+		if errors.Is(err, entity.ErrInvalidStatusTransition) {
+			lib.RespondError(w, http.StatusConflict, err.Error())
+			return
+		}
+
 		switch err.Error() {
 		case "user not found", "event not found":
 			lib.RespondError(w, http.StatusNotFound, err.Error())
@@ -57,7 +85,32 @@ func (h *FinishEventHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	lib.RespondJSON(w, http.StatusAccepted, map[string]string{
-		"message": "certificate jobs enqueued",
-	})
+	lib.RespondJSON(w, http.StatusAccepted, finishEventOutputToResponse(output))
+}
+
+// Mappers
+func finishEventOutputToResponse(output *finishevent.Output) FinishEventResponse {
+	notQualified := make([]NotQualifiedUserResponse, len(output.NotQualified))
+	for i, user := range output.NotQualified {
+		notQualified[i] = NotQualifiedUserResponse{
+			UserID:             user.UserID,
+			Name:               user.Name,
+			Email:              user.Email,
+			AttendedActivities: user.AttendedActivities,
+			AttendedMinutes:    user.AttendedMinutes,
+		}
+	}
+
+	message := "certificate jobs enqueued"
+	if output.EnqueuedJobs == 0 {
+		message = "no new certificates to enqueue"
+	}
+
+	return FinishEventResponse{
+		Message:              message,
+		EnqueuedJobs:         output.EnqueuedJobs,
+		ExistingCertificates: output.ExistingCertificates,
+		QualifiedUsers:       output.QualifiedUsers,
+		NotQualified:         notQualified,
+	}
 }
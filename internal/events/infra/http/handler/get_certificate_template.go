@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	getcertificatetemplate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/get_certificate_template"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Response DTOs
+type CertificateTemplateResponse struct {
+	EventID        string                         `json:"event_id"`
+	IsDefault      bool                           `json:"is_default"`
+	Title          string                         `json:"title"`
+	BodyText       string                         `json:"body_text"`
+	Signatories    []CertificateSignatoryResponse `json:"signatories"`
+	HasLogo        bool                           `json:"has_logo"`
+	PrimaryColor   string                         `json:"primary_color"`
+	AccentColor    string                         `json:"accent_color"`
+	SecondaryColor string                         `json:"secondary_color"`
+	Placeholders   []string                       `json:"placeholders"`
+	UpdatedAt      *time.Time                     `json:"updated_at,omitempty"`
+}
+
+type CertificateSignatoryResponse struct {
+	Name              string `json:"name"`
+	Title             string `json:"title"`
+	HasSignatureImage bool   `json:"has_signature_image"`
+}
+
+// Handler
+type GetCertificateTemplateHandler struct {
+	useCase *getcertificatetemplate.UseCase
+}
+
+func NewGetCertificateTemplateHandler(uc *getcertificatetemplate.UseCase) *GetCertificateTemplateHandler {
+	return &GetCertificateTemplateHandler{useCase: uc}
+}
+
+// Handle gets the certificate template of an event.
+// @Summary      Get certificate template
+// @Description  Gets the certificate template of an event. Returns the default template (is_default=true) when the event has none. Admin only.
+// @Tags         Certificates
+// @Produce      json
+// @Param        event_id  path      string  true  "Event ID"
+// @Success      200   {object}  CertificateTemplateResponse
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/certificate-template [get]
+func (h *GetCertificateTemplateHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &getcertificatetemplate.Input{
+		UserID:  middleware.GetUserID(r.Context()),
+		EventID: chi.URLParam(r, "event_id"),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		switch err.Error() {
+		case "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case "event not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, certificateTemplateToResponse(output.Template))
+}
+
+// Mappers
+func certificateTemplateToResponse(template *entity.CertificateTemplate) CertificateTemplateResponse {
+	signatories := make([]CertificateSignatoryResponse, len(template.Signatories))
+	for i, s := range template.Signatories {
+		signatories[i] = CertificateSignatoryResponse{
+			Name:              s.Name,
+			Title:             s.Title,
+			HasSignatureImage: s.SignatureKey != nil,
+		}
+	}
+
+	return CertificateTemplateResponse{
+		EventID:        template.EventID,
+		IsDefault:      template.IsDefault(),
+		Title:          template.Title,
+		BodyText:       template.BodyText,
+		Signatories:    signatories,
+		HasLogo:        template.LogoKey != nil,
+		PrimaryColor:   template.PrimaryColor,
+		AccentColor:    template.AccentColor,
+		SecondaryColor: template.SecondaryColor,
+		Placeholders:   entity.CertificatePlaceholders(),
+		UpdatedAt:      template.UpdatedAt,
+	}
+}
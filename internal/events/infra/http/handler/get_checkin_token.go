@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	getcheckintoken "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/get_checkin_token"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// tamanho em pixels do QR code exibido no projetor
+const checkInQRCodeSize = 512
+
+// Response DTOs
+type CheckInTokenResponse struct {
+	ActivityID    string    `json:"activity_id"`
+	Token         string    `json:"token"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	PeriodSeconds int       `json:"period_seconds"`
+	CheckInURL    string    `json:"checkin_url"`
+}
+
+// Handler
+type GetCheckInTokenHandler struct {
+	useCase *getcheckintoken.UseCase
+}
+
+func NewGetCheckInTokenHandler(uc *getcheckintoken.UseCase) *GetCheckInTokenHandler {
+	return &GetCheckInTokenHandler{useCase: uc}
+}
+
+// Handle returns the current rotating check-in token of an activity.
+// @Summary      Get check-in token
+// @Description  Returns the current rotating check-in token of an activity and when it expires. The token changes every period_seconds; poll this endpoint (or the QR endpoint) to keep the projector updated. Admin only.
+// @Tags         CheckIn
+// @Produce      json
+// @Param        activity_id  path      string  true  "Activity ID"
+// @Success      200   {object}  CheckInTokenResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Check-in token not enabled for activity"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Activity not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /activities/{activity_id}/checkin-token [get]
+func (h *GetCheckInTokenHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	output, ok := h.execute(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	lib.RespondJSON(w, http.StatusOK, checkInTokenOutputToResponse(output))
+}
+
+// HandleQRCode renders the current check-in token as a QR code.
+// @Summary      Get check-in QR code
+// @Description  Renders the current rotating check-in token of an activity as a PNG QR code, to be shown on the projector. The QR code encodes the check-in app URL (CHECKIN_APP_URL) with activity_id and token in the query, or just the token when no app URL is configured. Admin only.
+// @Tags         CheckIn
+// @Produce      png
+// @Param        activity_id  path      string  true  "Activity ID"
+// @Success      200   {file}    binary
+// @Failure      400   {object}  lib.ErrorResponse  "Check-in token not enabled for activity"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Activity not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /activities/{activity_id}/checkin-token/qr [get]
+func (h *GetCheckInTokenHandler) HandleQRCode(w http.ResponseWriter, r *http.Request) {
+	output, ok := h.execute(w, r)
+	if !ok {
+		return
+	}
+
+	content, err := lib.GenerateQRCodePNG(output.CheckInURL, checkInQRCodeSize)
+	if err != nil {
+		lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	lib.RespondFile(w, "image/png", "", content)
+}
+
+func (h *GetCheckInTokenHandler) execute(w http.ResponseWriter, r *http.Request) (*getcheckintoken.Output, bool) {
+	input := &getcheckintoken.Input{
+		UserID:     middleware.GetUserID(r.Context()),
+		ActivityID: chi.URLParam(r, "activity_id"),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		switch err.Error() {
+		case "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case "activity not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		case "check-in token not enabled for activity":
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return nil, false
+	}
+
+	return output, true
+}
+
+// Mappers
+func checkInTokenOutputToResponse(output *getcheckintoken.Output) CheckInTokenResponse {
+	return CheckInTokenResponse{
+		ActivityID:    output.ActivityID,
+		Token:         output.Token,
+		ExpiresAt:     output.ExpiresAt,
+		PeriodSeconds: output.PeriodSeconds,
+		CheckInURL:    output.CheckInURL,
+	}
+}
@@ -35,6 +35,12 @@ type CheckInResponse struct {
 	UserID     string    `json:"user_id"`
 	ActivityID string    `json:"activity_id"`
 	CheckedAt  time.Time `json:"checked_at"`
+	// preenchidos após o check-out
+	CheckedOutAt    *time.Time `json:"checked_out_at,omitempty"`
+	AttendedMinutes *int       `json:"attended_minutes,omitempty"`
+	// preenchidos no check-in manual feito por um admin
+	RegisteredBy       *string `json:"registered_by,omitempty"`
+	RegistrationReason *string `json:"registration_reason,omitempty"`
 }
 
 // Handler
@@ -91,10 +97,14 @@ func toEventDetailsResponse(data *repository.EventWithActivitiesAndCheckIns) *Ev
 		checkIns := make([]CheckInResponse, len(a.CheckIns))
 		for j, c := range a.CheckIns {
 			checkIns[j] = CheckInResponse{
-				ID:         c.ID,
-				UserID:     c.UserID,
-				ActivityID: c.ActivityID,
-				CheckedAt:  c.CheckedAt,
+				ID:                 c.ID,
+				UserID:             c.UserID,
+				ActivityID:         c.ActivityID,
+				CheckedAt:          c.CheckedAt,
+				CheckedOutAt:       c.CheckedOutAt,
+				AttendedMinutes:    c.AttendedMinutes,
+				RegisteredBy:       c.RegisteredBy,
+				RegistrationReason: c.RegistrationReason,
 			}
 		}
 		activities[i] = ActivityWithCheckInsResponse{
@@ -12,14 +12,16 @@ import (
 
 // Response DTOs
 type EventResponse struct {
-	ID             string     `json:"id"`
-	Name           string     `json:"name"`
-	AllowedDomains []string   `json:"allowed_domains"`
-	Description    *string    `json:"description,omitempty"`
-	StartDate      time.Time  `json:"start_date"`
-	EndDate        time.Time  `json:"end_date"`
-	CreatedAt      time.Time  `json:"created_at"`
-	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+	ID                   string     `json:"id"`
+	Name                 string     `json:"name"`
+	AllowedDomains       []string   `json:"allowed_domains"`
+	Description          *string    `json:"description,omitempty"`
+	StartDate            time.Time  `json:"start_date"`
+	EndDate              time.Time  `json:"end_date"`
+	Status               string     `json:"status"`
+	RegistrationRequired bool       `json:"registration_required"`
+	CreatedAt            time.Time  `json:"created_at"`
+	UpdatedAt            *time.Time `json:"updated_at,omitempty"`
 }
 
 type ActivityResponse struct {
@@ -29,6 +31,8 @@ type ActivityResponse struct {
 	Description *string    `json:"description,omitempty"`
 	StartDate   time.Time  `json:"start_date"`
 	EndDate     time.Time  `json:"end_date"`
+	Track       *string    `json:"track,omitempty"`
+	Capacity    *int       `json:"capacity,omitempty"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
 }
@@ -79,14 +83,16 @@ func (h *GetEventWithActivitiesHandler) Handle(w http.ResponseWriter, r *http.Re
 // Mappers (internal to this handler)
 func eventToResponse(event *entity.Event) EventResponse {
 	return EventResponse{
-		ID:             event.ID,
-		Name:           event.Name,
-		AllowedDomains: event.AllowedDomains,
-		Description:    event.Description,
-		StartDate:      event.StartDate,
-		EndDate:        event.EndDate,
-		CreatedAt:      event.CreatedAt,
-		UpdatedAt:      event.UpdatedAt,
+		ID:                   event.ID,
+		Name:                 event.Name,
+		AllowedDomains:       event.AllowedDomains,
+		Description:          event.Description,
+		StartDate:            event.StartDate,
+		EndDate:              event.EndDate,
+		Status:               string(event.Status),
+		RegistrationRequired: event.RegistrationRequired,
+		CreatedAt:            event.CreatedAt,
+		UpdatedAt:            event.UpdatedAt,
 	}
 }
 
@@ -98,6 +104,8 @@ func activityToResponse(activity *entity.Activity) ActivityResponse {
 		Description: activity.Description,
 		StartDate:   activity.StartDate,
 		EndDate:     activity.EndDate,
+		Track:       activity.Track,
+		Capacity:    activity.Capacity,
 		CreatedAt:   activity.CreatedAt,
 		UpdatedAt:   activity.UpdatedAt,
 	}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	listcertificatejobs "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_certificate_jobs"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Response DTOs
+type CertificateJobCountsResponse struct {
+	Total      int `json:"total"`
+	Queued     int `json:"queued"`
+	Generating int `json:"generating"`
+	Sending    int `json:"sending"`
+	Sent       int `json:"sent"`
+	Retrying   int `json:"retrying"`
+	Failed     int `json:"failed"`
+	Revoked    int `json:"revoked"`
+}
+
+type CertificateJobResponse struct {
+	JobID        string    `json:"job_id"`
+	Kind         string    `json:"kind"`
+	UserID       string    `json:"user_id"`
+	UserName     string    `json:"user_name"`
+	UserEmail    string    `json:"user_email"`
+	ActivityID   string    `json:"activity_id,omitempty"`
+	ActivityName string    `json:"activity_name,omitempty"`
+	Status       string    `json:"status" enums:"queued,generating,sending,sent,retrying,failed,revoked"`
+	Attempts     int       `json:"attempts"`
+	LastError    *string   `json:"last_error,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type ListCertificateJobsResponse struct {
+	Counts CertificateJobCountsResponse `json:"counts"`
+	Jobs   []CertificateJobResponse     `json:"jobs"`
+}
+
+// Handler
+type ListCertificateJobsHandler struct {
+	useCase *listcertificatejobs.UseCase
+}
+
+func NewListCertificateJobsHandler(uc *listcertificatejobs.UseCase) *ListCertificateJobsHandler {
+	return &ListCertificateJobsHandler{useCase: uc}
+}
+
+// Handle lists the certificate jobs of an event with their status.
+// @Summary      List certificate jobs
+// @Description  Lists the certificate jobs created when the event was finished, with the counts by status and the status of each participant's job (queued, generating, sending, sent, retrying after a failed attempt, or failed once the attempts are exhausted and the job is in the dead letter list; both keep the last error, or revoked when the certificate or check-in was revoked and the job was discarded). Admin only.
+// @Tags         Certificates
+// @Produce      json
+// @Param        event_id  path      string  true   "Event ID"
+// @Param        status    query     string  false  "Filter jobs by status" Enums(queued, generating, sending, sent, retrying, failed, revoked)
+// @Success      200   {object}  ListCertificateJobsResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid status"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/certificates/jobs [get]
+func (h *ListCertificateJobsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &listcertificatejobs.Input{
+		EventID: chi.URLParam(r, "event_id"),
+		UserID:  middleware.GetUserID(r.Context()),
+		Status:  r.URL.Query().Get("status"),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		respondCertificateJobError(w, err)
+		return
+	}
+
+	jobs := make([]CertificateJobResponse, len(output.Jobs))
+	for i, job := range output.Jobs {
+		jobs[i] = certificateJobToResponse(job)
+	}
+
+	lib.RespondJSON(w, http.StatusOK, ListCertificateJobsResponse{
+		Counts: certificateJobCountsToResponse(output.Counts),
+		Jobs:   jobs,
+	})
+}
+
+// Mappers
+func certificateJobCountsToResponse(counts map[repository.CertificateJobStatus]int) CertificateJobCountsResponse {
+	response := CertificateJobCountsResponse{
+		Total:      0,
+		Queued:     counts[repository.CertificateJobStatusQueued],
+		Generating: counts[repository.CertificateJobStatusGenerating],
+		Sending:    counts[repository.CertificateJobStatusSending],
+		Sent:       counts[repository.CertificateJobStatusSent],
+		Retrying:   counts[repository.CertificateJobStatusRetrying],
+		Failed:     counts[repository.CertificateJobStatusFailed],
+		Revoked:    counts[repository.CertificateJobStatusRevoked],
+	}
+	for _, count := range counts {
+		response.Total += count
+	}
+
+	return response
+}
+
+func certificateJobToResponse(record *repository.CertificateJobRecord) CertificateJobResponse {
+	job := record.Job
+
+	response := CertificateJobResponse{
+		JobID:        job.GetJobID(),
+		Kind:         string(job.GetKind()),
+		UserID:       job.GetUserInfo().UserID,
+		UserName:     job.GetUserInfo().UserName,
+		UserEmail:    job.GetUserInfo().UserEmail,
+		ActivityID:   "",
+		ActivityName: "",
+		Status:       string(record.Status),
+		Attempts:     record.Attempts,
+		LastError:    record.LastError,
+		CreatedAt:    record.CreatedAt,
+		UpdatedAt:    record.UpdatedAt,
+	}
+
+	if job.GetKind() == queue.CertificateJobKindActivity {
+		response.ActivityID = job.GetActivityInfo().ActivityID
+		response.ActivityName = job.GetActivityInfo().ActivityName
+	}
+
+	return response
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	listcheckinrejections "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_checkin_rejections"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Response DTOs
+type CheckInRejectionResponse struct {
+	ID             string    `json:"id"`
+	UserID         string    `json:"user_id"`
+	ActivityID     string    `json:"activity_id"`
+	Reason         string    `json:"reason"`
+	Latitude       *float64  `json:"latitude,omitempty"`
+	Longitude      *float64  `json:"longitude,omitempty"`
+	DistanceMeters *float64  `json:"distance_meters,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// Handler
+type ListCheckInRejectionsHandler struct {
+	useCase *listcheckinrejections.UseCase
+}
+
+func NewListCheckInRejectionsHandler(uc *listcheckinrejections.UseCase) *ListCheckInRejectionsHandler {
+	return &ListCheckInRejectionsHandler{useCase: uc}
+}
+
+// Handle lists rejected check-in attempts of an event.
+// @Summary      List check-in rejections
+// @Description  Lists the check-in attempts rejected by the venue geofence in the activities of an event, newest first. reason: location_missing or outside_venue. Admin only.
+// @Tags         CheckIn
+// @Produce      json
+// @Param        event_id  path      string  true  "Event ID"
+// @Success      200   {array}   CheckInRejectionResponse
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/checkin-rejections [get]
+func (h *ListCheckInRejectionsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &listcheckinrejections.Input{
+		UserID:  middleware.GetUserID(r.Context()),
+		EventID: chi.URLParam(r, "event_id"),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		switch err.Error() {
+		case "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case "event not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, checkInRejectionsToResponse(output.Rejections))
+}
+
+// Mappers
+func checkInRejectionsToResponse(rejections []*entity.CheckInRejection) []CheckInRejectionResponse {
+	responses := make([]CheckInRejectionResponse, len(rejections))
+	for i, rejection := range rejections {
+		responses[i] = CheckInRejectionResponse{
+			ID:             rejection.ID,
+			UserID:         rejection.UserID,
+			ActivityID:     rejection.ActivityID,
+			Reason:         string(rejection.Reason),
+			Latitude:       rejection.Latitude,
+			Longitude:      rejection.Longitude,
+			DistanceMeters: rejection.DistanceMeters,
+			CreatedAt:      rejection.CreatedAt,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	listdeadletterjobs "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_dead_letter_jobs"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+)
+
+// Response DTOs
+type DeadLetterJobResponse struct {
+	JobID        string    `json:"job_id"`
+	Kind         string    `json:"kind"`
+	EventID      string    `json:"event_id"`
+	EventName    string    `json:"event_name"`
+	UserID       string    `json:"user_id"`
+	UserEmail    string    `json:"user_email"`
+	ActivityID   string    `json:"activity_id,omitempty"`
+	ActivityName string    `json:"activity_name,omitempty"`
+	Attempts     int       `json:"attempts"`
+	LastError    *string   `json:"last_error,omitempty"`
+	EnqueuedAt   time.Time `json:"enqueued_at"`
+}
+
+// Handler
+type ListDeadLetterJobsHandler struct {
+	useCase *listdeadletterjobs.UseCase
+}
+
+func NewListDeadLetterJobsHandler(uc *listdeadletterjobs.UseCase) *ListDeadLetterJobsHandler {
+	return &ListDeadLetterJobsHandler{useCase: uc}
+}
+
+// Handle lists certificate jobs that exhausted their retries.
+// @Summary      List dead letter certificate jobs
+// @Description  Lists certificate jobs that exhausted all retry attempts. Admin only.
+// @Tags         Certificates
+// @Produce      json
+// @Param        limit  query     int  false  "Max number of jobs (default 50, max 500)"
+// @Success      200   {array}   DeadLetterJobResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid limit"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /certificates/dead-letters [get]
+func (h *ListDeadLetterJobsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+
+	var limit int64
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			lib.RespondError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		limit = parsed
+	}
+
+	input := &listdeadletterjobs.Input{
+		UserID: userID,
+		Limit:  limit,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		if err.Error() == "user is not an admin" {
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+			return
+		}
+		lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, deadLetterJobsToResponse(output.Jobs))
+}
+
+// Mappers
+func deadLetterJobToResponse(job *queue.CertificateJob) DeadLetterJobResponse {
+	return DeadLetterJobResponse{
+		JobID:        job.GetJobID(),
+		Kind:         string(job.GetKind()),
+		EventID:      job.GetEventInfo().EventID,
+		EventName:    job.GetEventInfo().EventName,
+		UserID:       job.GetUserInfo().UserID,
+		UserEmail:    job.GetUserInfo().UserEmail,
+		ActivityID:   job.GetActivityInfo().ActivityID,
+		ActivityName: job.GetActivityInfo().ActivityName,
+		Attempts:     job.GetAttempts(),
+		LastError:    job.LastError,
+		EnqueuedAt:   job.GetEnqueuedAt(),
+	}
+}
+
+func deadLetterJobsToResponse(jobs []*queue.CertificateJob) []DeadLetterJobResponse {
+	result := make([]DeadLetterJobResponse, len(jobs))
+	for i, job := range jobs {
+		result[i] = deadLetterJobToResponse(job)
+	}
+	return result
+}
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	listevents "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_events"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+)
+
+// Request DTOs
+type ListEventsRequest struct {
+	Statuses []string   `validate:"dive,oneof=draft published cancelled completed"`
+	From     *time.Time `validate:"omitempty"`
+	To       *time.Time `validate:"omitempty"`
+	Search   string     `validate:"max=255"`
+	Cursor   string     `validate:"max=512"`
+	Limit    int        `validate:"omitempty,min=1,max=100"`
+}
+
+// Response DTOs
+type ListEventsResponse struct {
+	Events     []EventResponse `json:"events"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+// Handler
+type ListEventsHandler struct {
+	useCase *listevents.UseCase
+}
+
+func NewListEventsHandler(uc *listevents.UseCase) *ListEventsHandler {
+	return &ListEventsHandler{useCase: uc}
+}
+
+// Handle lists events with cursor-based pagination.
+// @Summary      List events
+// @Description  Lists events from the most recent, with cursor-based pagination. Admins see every event and can filter by status; other users only see published events open to their email domain. Use next_cursor as the cursor of the next page.
+// @Tags         Events
+// @Produce      json
+// @Param        status  query     string  false  "Comma-separated statuses (draft, published, cancelled, completed), admin only"
+// @Param        from    query     string  false  "Events ending at or after this date (RFC3339)"
+// @Param        to      query     string  false  "Events starting at or before this date (RFC3339)"
+// @Param        q       query     string  false  "Search by name"
+// @Param        cursor  query     string  false  "Cursor returned by the previous page"
+// @Param        limit   query     int     false  "Page size (default 20, max 100)"
+// @Success      200   {object}  ListEventsResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid filters or cursor"
+// @Failure      401   {object}  lib.ErrorResponse  "Unauthorized"
+// @Failure      404   {object}  lib.ErrorResponse  "User not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events [get]
+func (h *ListEventsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	req, err := listEventsQueryToRequest(r)
+	if err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := lib.Validate(req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	output, err := h.useCase.Execute(r.Context(), listEventsRequestToInput(req, middleware.GetUserID(r.Context())))
+	if err != nil {
+		switch err.Error() {
+		case "user not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		case "invalid cursor", "from must be before to":
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	events := make([]EventResponse, len(output.Events))
+	for i, event := range output.Events {
+		events[i] = eventToResponse(event)
+	}
+
+	lib.RespondJSON(w, http.StatusOK, ListEventsResponse{
+		Events:     events,
+		NextCursor: output.NextCursor,
+	})
+}
+
+// Mappers
+func listEventsQueryToRequest(r *http.Request) (*ListEventsRequest, error) {
+	query := r.URL.Query()
+
+	req := &ListEventsRequest{
+		Statuses: nil,
+		From:     nil,
+		To:       nil,
+		Search:   query.Get("q"),
+		Cursor:   query.Get("cursor"),
+		Limit:    0,
+	}
+
+	if raw := query.Get("status"); raw != "" {
+		for _, status := range strings.Split(raw, ",") {
+			req.Statuses = append(req.Statuses, strings.TrimSpace(status))
+		}
+	}
+
+	for param, dst := range map[string]**time.Time{"from": &req.From, "to": &req.To} {
+		raw := query.Get(param)
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: must be RFC3339", param)
+		}
+		*dst = &parsed
+	}
+
+	if raw := query.Get("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit")
+		}
+		req.Limit = limit
+	}
+
+	return req, nil
+}
+
+func listEventsRequestToInput(req *ListEventsRequest, userID string) *listevents.Input {
+	statuses := make([]entity.EventStatus, len(req.Statuses))
+	for i, status := range req.Statuses {
+		statuses[i] = entity.EventStatus(status)
+	}
+
+	return &listevents.Input{
+		UserID:   userID,
+		Statuses: statuses,
+		From:     req.From,
+		To:       req.To,
+		Search:   req.Search,
+		Cursor:   req.Cursor,
+		Limit:    req.Limit,
+	}
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	listusercertificates "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_user_certificates"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+)
+
+// Response DTOs
+type UserCertificateResponse struct {
+	ID               string     `json:"id"`
+	VerificationCode string     `json:"verification_code"`
+	Valid            bool       `json:"valid"`
+	EventID          string     `json:"event_id"`
+	EventName        string     `json:"event_name"`
+	ActivityID       *string    `json:"activity_id,omitempty"`
+	ActivityName     *string    `json:"activity_name,omitempty"`
+	WorkloadMinutes  int        `json:"workload_minutes"`
+	Workload         string     `json:"workload"`
+	IssuedAt         time.Time  `json:"issued_at"`
+	RevokedAt        *time.Time `json:"revoked_at,omitempty"`
+	PDFAvailable     bool       `json:"pdf_available"`
+}
+
+// Handler
+type ListUserCertificatesHandler struct {
+	useCase *listusercertificates.UseCase
+}
+
+func NewListUserCertificatesHandler(uc *listusercertificates.UseCase) *ListUserCertificatesHandler {
+	return &ListUserCertificatesHandler{useCase: uc}
+}
+
+// Handle lists the certificates issued to the authenticated user.
+// @Summary      List my certificates
+// @Description  Lists all certificates issued to the authenticated user, newest first. Certificates with pdf_available can be downloaded from /certificates/{certificate_id}/pdf.
+// @Tags         Certificates
+// @Produce      json
+// @Success      200   {array}   UserCertificateResponse
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /me/certificates [get]
+func (h *ListUserCertificatesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+
+	input := &listusercertificates.Input{
+		UserID: userID,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, userCertificatesToResponse(output.Certificates))
+}
+
+// Mappers
+func userCertificatesToResponse(certificates []*entity.Certificate) []UserCertificateResponse {
+	resp := make([]UserCertificateResponse, len(certificates))
+	for i, c := range certificates {
+		resp[i] = UserCertificateResponse{
+			ID:               c.ID,
+			VerificationCode: c.VerificationCode,
+			Valid:            c.IsValid(),
+			EventID:          c.EventID,
+			EventName:        c.EventName,
+			ActivityID:       c.ActivityID,
+			ActivityName:     c.ActivityName,
+			WorkloadMinutes:  c.WorkloadMinutes,
+			Workload:         entity.FormatWorkload(c.Workload()),
+			IssuedAt:         c.IssuedAt,
+			RevokedAt:        c.RevokedAt,
+			PDFAvailable:     c.HasFile(),
+		}
+	}
+	return resp
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	listusercheckins "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_user_checkins"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+)
+
+// Response DTOs
+type UserCheckInResponse struct {
+	ID                string     `json:"id"`
+	CheckedAt         time.Time  `json:"checked_at"`
+	CheckedOutAt      *time.Time `json:"checked_out_at,omitempty"`
+	AttendedMinutes   *int       `json:"attended_minutes,omitempty"`
+	Manual            bool       `json:"manual"`
+	ActivityID        string     `json:"activity_id"`
+	ActivityName      string     `json:"activity_name"`
+	ActivityStartDate time.Time  `json:"activity_start_date"`
+	ActivityEndDate   time.Time  `json:"activity_end_date"`
+	EventID           string     `json:"event_id"`
+	EventName         string     `json:"event_name"`
+	EventStatus       string     `json:"event_status"`
+	CertificateStatus string     `json:"certificate_status"`
+	CertificateID     *string    `json:"certificate_id,omitempty"`
+}
+
+type ListUserCheckInsResponse struct {
+	CheckIns   []UserCheckInResponse `json:"check_ins"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+}
+
+// Handler
+type ListUserCheckInsHandler struct {
+	useCase *listusercheckins.UseCase
+}
+
+func NewListUserCheckInsHandler(uc *listusercheckins.UseCase) *ListUserCheckInsHandler {
+	return &ListUserCheckInsHandler{useCase: uc}
+}
+
+// Handle lists the check-ins of the authenticated user.
+// @Summary      List my check-ins
+// @Description  Lists the active check-ins of the authenticated user, newest first, with the activity, the event and the certificate status (not_available, pending, issued or revoked). Use next_cursor as the cursor of the next page.
+// @Tags         CheckIn
+// @Produce      json
+// @Param        event_id  query     string  false  "Only check-ins of this event"
+// @Param        cursor    query     string  false  "Cursor returned by the previous page"
+// @Param        limit     query     int     false  "Page size (default 20, max 100)"
+// @Success      200   {object}  ListUserCheckInsResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid limit or cursor"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /me/checkins [get]
+func (h *ListUserCheckInsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	query := r.URL.Query()
+
+	var limit int
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			lib.RespondError(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		limit = parsed
+	}
+
+	var eventID *string
+	if raw := query.Get("event_id"); raw != "" {
+		eventID = &raw
+	}
+
+	input := &listusercheckins.Input{
+		UserID:  userID,
+		EventID: eventID,
+		Cursor:  query.Get("cursor"),
+		Limit:   limit,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		if err.Error() == "invalid cursor" {
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, ListUserCheckInsResponse{
+		CheckIns:   userCheckInsToResponse(output.CheckIns),
+		NextCursor: output.NextCursor,
+	})
+}
+
+// Mappers
+func userCheckInsToResponse(checkIns []*repository.UserCheckIn) []UserCheckInResponse {
+	resp := make([]UserCheckInResponse, len(checkIns))
+	for i, c := range checkIns {
+		resp[i] = UserCheckInResponse{
+			ID:                c.ID,
+			CheckedAt:         c.CheckedAt,
+			CheckedOutAt:      c.CheckedOutAt,
+			AttendedMinutes:   c.AttendedMinutes,
+			Manual:            c.IsManual(),
+			ActivityID:        c.ActivityID,
+			ActivityName:      c.ActivityName,
+			ActivityStartDate: c.ActivityStartDate,
+			ActivityEndDate:   c.ActivityEndDate,
+			EventID:           c.EventID,
+			EventName:         c.EventName,
+			EventStatus:       string(c.EventStatus),
+			CertificateStatus: string(c.CertificateStatus()),
+			CertificateID:     c.CertificateID,
+		}
+	}
+	return resp
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"net/http"
+
+	previewcertificatetemplate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/preview_certificate_template"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler
+type PreviewCertificateTemplateHandler struct {
+	useCase *previewcertificatetemplate.UseCase
+}
+
+func NewPreviewCertificateTemplateHandler(uc *previewcertificatetemplate.UseCase) *PreviewCertificateTemplateHandler {
+	return &PreviewCertificateTemplateHandler{useCase: uc}
+}
+
+// Handle renders a sample certificate with the event template.
+// @Summary      Preview certificate template
+// @Description  Renders a sample certificate PDF using the current template of the event (or the default one) and fictitious participant data. Admin only.
+// @Tags         Certificates
+// @Produce      application/pdf
+// @Param        event_id  path      string  true  "Event ID"
+// @Success      200   {file}    file
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/certificate-template/preview [get]
+func (h *PreviewCertificateTemplateHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &previewcertificatetemplate.Input{
+		UserID:  middleware.GetUserID(r.Context()),
+		EventID: chi.URLParam(r, "event_id"),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		switch err.Error() {
+		case "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case "event not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondFile(w, "application/pdf", "", output.Content)
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	publishevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/publish_event"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Response DTOs
+type EventStatusResponse struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// Handler
+type PublishEventHandler struct {
+	useCase *publishevent.UseCase
+}
+
+func NewPublishEventHandler(uc *publishevent.UseCase) *PublishEventHandler {
+	return &PublishEventHandler{useCase: uc}
+}
+
+// Handle publishes a draft event.
+// @Summary      Publish event
+// @Description  Publishes a draft event, opening it for check-ins. Admin only.
+// @Tags         Events
+// @Produce      json
+// @Param        event_id  path      string  true  "Event ID"
+// @Success      200   {object}  EventStatusResponse
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Event is not a draft"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/publish [post]
+func (h *PublishEventHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &publishevent.Input{
+		UserID:  middleware.GetUserID(r.Context()),
+		EventID: chi.URLParam(r, "event_id"),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		respondEventStatusError(w, err)
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, eventToStatusResponse(output.Event))
+}
+
+// respondEventStatusError mapeia os erros das transições de status do evento
+func respondEventStatusError(w http.ResponseWriter, err error) {
+	if errors.Is(err, entity.ErrInvalidStatusTransition) {
+		lib.RespondError(w, http.StatusConflict, err.Error())
+		return
+	}
+
+	switch err.Error() {
+	case "user is not an admin":
+		lib.RespondError(w, http.StatusForbidden, err.Error())
+	case "event not found":
+		lib.RespondError(w, http.StatusNotFound, err.Error())
+	default:
+		lib.RespondError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// Mappers
+func eventToStatusResponse(event *entity.Event) EventStatusResponse {
+	return EventStatusResponse{
+		ID:     event.ID,
+		Name:   event.Name,
+		Status: string(event.Status),
+	}
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	registerforevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/register_for_event"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Request DTOs
+type RegisterForEventRequest struct {
+	ActivityIDs []string `json:"activity_ids,omitempty" validate:"max=20,dive,required"`
+}
+
+// Response DTOs
+type RegistrationResponse struct {
+	ID                   string     `json:"id"`
+	UserID               string     `json:"user_id"`
+	EventID              string     `json:"event_id"`
+	ActivityID           *string    `json:"activity_id,omitempty"`
+	Status               string     `json:"status"`
+	CreatedAt            time.Time  `json:"created_at"`
+	CancelledAt          *time.Time `json:"cancelled_at,omitempty"`
+	ConfirmationDeadline *time.Time `json:"confirmation_deadline,omitempty"`
+}
+
+// Handler
+type RegisterForEventHandler struct {
+	useCase *registerforevent.UseCase
+}
+
+func NewRegisterForEventHandler(uc *registerforevent.UseCase) *RegisterForEventHandler {
+	return &RegisterForEventHandler{useCase: uc}
+}
+
+// Handle registers the authenticated user for an event and, optionally, for some of its activities.
+// @Summary      Register for event
+// @Description  Registers the authenticated user for a published event and, optionally, for activities of the event. The event registration is created with the first activity registration. When an activity with limited capacity is full, the registration joins its waitlist (status waitlisted); when a spot opens, the next in line gets an email and must confirm it before the confirmation deadline, otherwise the spot passes to the next person. The user email domain must be allowed by the event.
+// @Tags         Registrations
+// @Accept       json
+// @Produce      json
+// @Param        event_id  path      string                   true   "Event ID"
+// @Param        request   body      RegisterForEventRequest  false  "Activities to register for"
+// @Param        Idempotency-Key  header  string  false  "Replays the stored response when the same key is sent again"
+// @Success      201   {array}   RegistrationResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid request body"
+// @Failure      403   {object}  lib.ErrorResponse  "User domain not allowed"
+// @Failure      404   {object}  lib.ErrorResponse  "Event or activity not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Already registered"
+// @Failure      422   {object}  lib.ErrorResponse  "Event not open for registration"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/registrations [post]
+func (h *RegisterForEventHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	// o corpo é opcional
+	var req RegisterForEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input := &registerforevent.Input{
+		UserID:      middleware.GetUserID(r.Context()),
+		EventID:     chi.URLParam(r, "event_id"),
+		ActivityIDs: req.ActivityIDs,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		respondRegistrationError(w, err)
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusCreated, registrationsToResponse(output.Registrations))
+}
+
+// respondRegistrationError mapeia os erros de inscrição e cancelamento
+func respondRegistrationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, entity.ErrAlreadyRegistered), errors.Is(err, entity.ErrRegistrationNotActive),
+		errors.Is(err, entity.ErrRegistrationNotOffered), errors.Is(err, entity.ErrConfirmationExpired):
+		lib.RespondError(w, http.StatusConflict, err.Error())
+		return
+	case errors.Is(err, entity.ErrRegistrationClosed):
+		lib.RespondError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	switch err.Error() {
+	case "user not found", "event not found", "activity not found", "registration not found":
+		lib.RespondError(w, http.StatusNotFound, err.Error())
+	case "user domain not allowed":
+		lib.RespondError(w, http.StatusForbidden, err.Error())
+	default:
+		lib.RespondError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// Mappers
+func registrationToResponse(registration *entity.Registration) RegistrationResponse {
+	return RegistrationResponse{
+		ID:                   registration.ID,
+		UserID:               registration.UserID,
+		EventID:              registration.EventID,
+		ActivityID:           registration.ActivityID,
+		Status:               string(registration.Status),
+		CreatedAt:            registration.CreatedAt,
+		CancelledAt:          registration.CancelledAt,
+		ConfirmationDeadline: registration.ConfirmationDeadline,
+	}
+}
+
+func registrationsToResponse(registrations []*entity.Registration) []RegistrationResponse {
+	resp := make([]RegistrationResponse, len(registrations))
+	for i, registration := range registrations {
+		resp[i] = registrationToResponse(registration)
+	}
+	return resp
+}
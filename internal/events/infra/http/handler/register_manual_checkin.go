@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	registermanualcheckin "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/register_manual_checkin"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Request DTOs
+type RegisterManualCheckInRequest struct {
+	UserID             *string `json:"user_id,omitempty"`
+	Email              *string `json:"email,omitempty" validate:"omitempty,email"`
+	CPF                *string `json:"cpf,omitempty"`
+	Reason             string  `json:"reason" validate:"required,max=500"`
+	OverrideTimeWindow bool    `json:"override_time_window"`
+}
+
+// Response DTOs
+type RegisterManualCheckInResponse struct {
+	ID                 string    `json:"id"`
+	UserID             string    `json:"user_id"`
+	ActivityID         string    `json:"activity_id"`
+	CheckedAt          time.Time `json:"checked_at"`
+	RegisteredBy       string    `json:"registered_by"`
+	RegistrationReason string    `json:"registration_reason"`
+}
+
+// Handler
+type RegisterManualCheckInHandler struct {
+	useCase *registermanualcheckin.UseCase
+}
+
+func NewRegisterManualCheckInHandler(uc *registermanualcheckin.UseCase) *RegisterManualCheckInHandler {
+	return &RegisterManualCheckInHandler{useCase: uc}
+}
+
+// Handle registers a check-in on behalf of an attendee.
+// @Summary      Register manual check-in
+// @Description  Registers a check-in for another user, found by user ID, email or CPF (exactly one). Only admins can register manual check-ins. The QR token and venue checks are skipped, the event domain rules still apply and the time window can be overridden. The admin and the reason are recorded on the check-in.
+// @Tags         CheckIn
+// @Accept       json
+// @Produce      json
+// @Param        activity_id  path      string                        true  "Activity ID"
+// @Param        request      body      RegisterManualCheckInRequest  true  "Attendee and reason"
+// @Param        Idempotency-Key  header  string  false  "Replays the stored response when the same key is sent again"
+// @Success      201   {object}  RegisterManualCheckInResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid request or already checked in"
+// @Failure      401   {object}  lib.ErrorResponse  "Unauthorized"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin or attendee domain not allowed"
+// @Failure      404   {object}  lib.ErrorResponse  "Activity or user not found"
+// @Failure      422   {object}  lib.ErrorResponse  "Outside the activity check-in window or event not open for check-in"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /activities/{activity_id}/checkins [post]
+func (h *RegisterManualCheckInHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	activityID := chi.URLParam(r, "activity_id")
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		lib.RespondError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req RegisterManualCheckInRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	output, err := h.useCase.Execute(r.Context(), registerManualCheckInRequestToInput(&req, userID, activityID))
+	if err != nil {
+		if errors.Is(err, entity.ErrCheckInOutsideWindow) || errors.Is(err, entity.ErrEventNotOpenForCheckIn) {
+			lib.RespondError(w, http.StatusUnprocessableEntity, err.Error())
+			return
+		}
+		if errors.Is(err, entity.ErrAlreadyCheckedIn) {
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		switch err.Error() {
+		case "activity not found", "user not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		case "invalid cpf", "exactly one of user_id, email or cpf is required":
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+		case "user is not an admin", "user domain not allowed":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusCreated, registerManualCheckInOutputToResponse(output))
+}
+
+// Mappers
+func registerManualCheckInRequestToInput(req *RegisterManualCheckInRequest, adminID, activityID string) *registermanualcheckin.Input {
+	input := &registermanualcheckin.Input{
+		AdminID:            adminID,
+		ActivityID:         activityID,
+		AttendeeID:         "",
+		Email:              "",
+		CPF:                "",
+		Reason:             req.Reason,
+		OverrideTimeWindow: req.OverrideTimeWindow,
+	}
+	if req.UserID != nil {
+		input.AttendeeID = *req.UserID
+	}
+	if req.Email != nil {
+		input.Email = *req.Email
+	}
+	if req.CPF != nil {
+		input.CPF = *req.CPF
+	}
+	return input
+}
+
+func registerManualCheckInOutputToResponse(output *registermanualcheckin.Output) *RegisterManualCheckInResponse {
+	resp := &RegisterManualCheckInResponse{
+		ID:                 output.CheckIn.ID,
+		UserID:             output.CheckIn.UserID,
+		ActivityID:         output.CheckIn.ActivityID,
+		CheckedAt:          output.CheckIn.CheckedAt,
+		RegisteredBy:       "",
+		RegistrationReason: "",
+	}
+	if output.CheckIn.RegisteredBy != nil {
+		resp.RegisteredBy = *output.CheckIn.RegisteredBy
+	}
+	if output.CheckIn.RegistrationReason != nil {
+		resp.RegistrationReason = *output.CheckIn.RegistrationReason
+	}
+	return resp
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	reopenevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/reopen_event"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler
+type ReopenEventHandler struct {
+	useCase *reopenevent.UseCase
+}
+
+func NewReopenEventHandler(uc *reopenevent.UseCase) *ReopenEventHandler {
+	return &ReopenEventHandler{useCase: uc}
+}
+
+// Handle reopens a cancelled or completed event.
+// @Summary      Reopen event
+// @Description  Moves a cancelled or completed event back to published, accepting check-ins again. Admin only.
+// @Tags         Events
+// @Produce      json
+// @Param        event_id  path      string  true  "Event ID"
+// @Success      200   {object}  EventStatusResponse
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Event is not cancelled or completed"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/reopen [post]
+func (h *ReopenEventHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &reopenevent.Input{
+		UserID:  middleware.GetUserID(r.Context()),
+		EventID: chi.URLParam(r, "event_id"),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		respondEventStatusError(w, err)
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, eventToStatusResponse(output.Event))
+}
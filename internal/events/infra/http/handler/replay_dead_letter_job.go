@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	replaydeadletterjob "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/replay_dead_letter_job"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+type ReplayDeadLetterJobHandler struct {
+	useCase *replaydeadletterjob.UseCase
+}
+
+func NewReplayDeadLetterJobHandler(uc *replaydeadletterjob.UseCase) *ReplayDeadLetterJobHandler {
+	return &ReplayDeadLetterJobHandler{useCase: uc}
+}
+
+// Handle moves a dead letter job back to the certificate queue.
+// @Summary      Replay dead letter certificate job
+// @Description  Moves a job from the dead letter list back to the certificate queue with its attempts reset. The job status goes back to queued, so it cannot be resent while the replayed copy is being processed. Admin only.
+// @Tags         Certificates
+// @Produce      json
+// @Param        job_id  path      string  true  "Job ID"
+// @Success      202   {object}  DeadLetterJobResponse
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Job not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /certificates/dead-letters/{job_id}/replay [post]
+func (h *ReplayDeadLetterJobHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	jobID := chi.URLParam(r, "job_id")
+	userID := middleware.GetUserID(r.Context())
+
+	input := &replaydeadletterjob.Input{
+		UserID: userID,
+		JobID:  jobID,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		switch {
+		case err.Error() == "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case errors.Is(err, queue.ErrJobNotFound):
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusAccepted, deadLetterJobToResponse(output.Job))
+}
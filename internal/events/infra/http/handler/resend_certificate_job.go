@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	resendcertificatejob "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/resend_certificate_job"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler
+type ResendCertificateJobHandler struct {
+	useCase *resendcertificatejob.UseCase
+}
+
+func NewResendCertificateJobHandler(uc *resendcertificatejob.UseCase) *ResendCertificateJobHandler {
+	return &ResendCertificateJobHandler{useCase: uc}
+}
+
+// Handle sends a certificate job back to the queue.
+// @Summary      Resend certificate job
+// @Description  Sends a sent or failed (dead-lettered) certificate job back to the queue and removes it from the dead letter list. The certificate already issued is reused and the email is sent again. Jobs still queued, being processed or waiting for a retry cannot be resent, nor jobs whose certificate or check-in was revoked. Admin only.
+// @Tags         Certificates
+// @Produce      json
+// @Param        event_id  path      string  true  "Event ID"
+// @Param        job_id    path      string  true  "Job ID"
+// @Success      202   {object}  CertificateJobResponse
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Job not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Job is still in progress or its certificate was revoked"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/certificates/jobs/{job_id}/resend [post]
+func (h *ResendCertificateJobHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &resendcertificatejob.Input{
+		EventID: chi.URLParam(r, "event_id"),
+		JobID:   chi.URLParam(r, "job_id"),
+		UserID:  middleware.GetUserID(r.Context()),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		respondCertificateJobError(w, err)
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusAccepted, certificateJobToResponse(output.Job))
+}
+
+func respondCertificateJobError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, queue.ErrJobNotFound):
+		lib.RespondError(w, http.StatusNotFound, err.Error())
+		return
+	case errors.Is(err, resendcertificatejob.ErrJobInProgress), errors.Is(err, resendcertificatejob.ErrCertificateRevoked):
+		lib.RespondError(w, http.StatusConflict, err.Error())
+		return
+	}
+
+	switch err.Error() {
+	case "user is not an admin":
+		lib.RespondError(w, http.StatusForbidden, err.Error())
+	case "event not found":
+		lib.RespondError(w, http.StatusNotFound, err.Error())
+	case "invalid status":
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		lib.RespondError(w, http.StatusInternalServerError, err.Error())
+	}
+}
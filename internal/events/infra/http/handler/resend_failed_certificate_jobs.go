@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"net/http"
+
+	resendfailedcertificatejobs "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/resend_failed_certificate_jobs"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Response DTOs
+type ResendFailedCertificateJobsResponse struct {
+	RequeuedJobs int64 `json:"requeued_jobs"`
+}
+
+// Handler
+type ResendFailedCertificateJobsHandler struct {
+	useCase *resendfailedcertificatejobs.UseCase
+}
+
+func NewResendFailedCertificateJobsHandler(uc *resendfailedcertificatejobs.UseCase) *ResendFailedCertificateJobsHandler {
+	return &ResendFailedCertificateJobsHandler{useCase: uc}
+}
+
+// Handle sends all failed certificate jobs of an event back to the queue.
+// @Summary      Resend failed certificate jobs
+// @Description  Sends every failed (dead-lettered) certificate job of the event back to the queue, removing them from the dead letter list, and returns how many were requeued. Jobs waiting for a retry are left to the queue and jobs whose certificate was revoked stay in the dead letter list. Admin only.
+// @Tags         Certificates
+// @Produce      json
+// @Param        event_id  path      string  true  "Event ID"
+// @Success      202   {object}  ResendFailedCertificateJobsResponse
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/certificates/jobs/resend-failed [post]
+func (h *ResendFailedCertificateJobsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &resendfailedcertificatejobs.Input{
+		EventID: chi.URLParam(r, "event_id"),
+		UserID:  middleware.GetUserID(r.Context()),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		respondCertificateJobError(w, err)
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusAccepted, ResendFailedCertificateJobsResponse{
+		RequeuedJobs: output.RequeuedJobs,
+	})
+}
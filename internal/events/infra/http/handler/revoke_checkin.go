@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	revokecheckin "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/revoke_checkin"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Request DTOs
+type RevokeCheckInRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// Response DTOs
+type RevokeCheckInResponse struct {
+	ID               string    `json:"id"`
+	UserID           string    `json:"user_id"`
+	ActivityID       string    `json:"activity_id"`
+	CheckedAt        time.Time `json:"checked_at"`
+	RevokedAt        time.Time `json:"revoked_at"`
+	RevokedBy        string    `json:"revoked_by"`
+	RevocationReason string    `json:"revocation_reason"`
+	// certificados revogados junto com o check-in
+	RevokedCertificates int `json:"revoked_certificates"`
+}
+
+// Handler
+type RevokeCheckInHandler struct {
+	useCase *revokecheckin.UseCase
+}
+
+func NewRevokeCheckInHandler(uc *revokecheckin.UseCase) *RevokeCheckInHandler {
+	return &RevokeCheckInHandler{useCase: uc}
+}
+
+// Handle revokes a check-in.
+// @Summary      Revoke check-in
+// @Description  Revokes a check-in made by mistake or by fraud. The check-in is kept for audit with the admin and the reason, but no longer counts for certificates nor appears in the event details. Certificates already issued for the activity are revoked in the same transaction, and so is the participant's consolidated event certificate when the remaining check-ins no longer meet the attendance rule. Only admins can revoke check-ins.
+// @Tags         CheckIn
+// @Accept       json
+// @Produce      json
+// @Param        activity_id  path      string                true  "Activity ID"
+// @Param        checkin_id   path      string                true  "Check-in ID"
+// @Param        request      body      RevokeCheckInRequest  true  "Revocation reason"
+// @Success      200   {object}  RevokeCheckInResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid request"
+// @Failure      401   {object}  lib.ErrorResponse  "Unauthorized"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Check-in not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Check-in already revoked"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /activities/{activity_id}/checkins/{checkin_id}/revoke [post]
+func (h *RevokeCheckInHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		lib.RespondError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req RevokeCheckInRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input := &revokecheckin.Input{
+		UserID:     userID,
+		ActivityID: chi.URLParam(r, "activity_id"),
+		CheckInID:  chi.URLParam(r, "checkin_id"),
+		Reason:     req.Reason,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		switch err.Error() {
+		case "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case "check-in not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		case "check-in already revoked":
+			lib.RespondError(w, http.StatusConflict, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, revokeCheckInOutputToResponse(output))
+}
+
+// Mappers
+func revokeCheckInOutputToResponse(output *revokecheckin.Output) *RevokeCheckInResponse {
+	c := output.CheckIn
+	return &RevokeCheckInResponse{
+		ID:                  c.ID,
+		UserID:              c.UserID,
+		ActivityID:          c.ActivityID,
+		CheckedAt:           c.CheckedAt,
+		RevokedAt:           *c.RevokedAt,
+		RevokedBy:           *c.RevokedBy,
+		RevocationReason:    *c.RevocationReason,
+		RevokedCertificates: output.RevokedCertificates,
+	}
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	updateactivity "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_activity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Request DTOs
+type UpdateActivityRequest struct {
+	Name        *string    `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Description *string    `json:"description,omitempty" validate:"omitempty,max=500"`
+	StartDate   *time.Time `json:"start_date,omitempty"`
+	EndDate     *time.Time `json:"end_date,omitempty"`
+	// string vazia remove a atividade da trilha
+	Track *string `json:"track,omitempty" validate:"omitempty,max=100"`
+	// número máximo de inscritos; 0 remove o limite
+	Capacity *int `json:"capacity,omitempty" validate:"omitempty,min=0"`
+}
+
+// Handler
+type UpdateActivityHandler struct {
+	useCase *updateactivity.UseCase
+}
+
+func NewUpdateActivityHandler(uc *updateactivity.UseCase) *UpdateActivityHandler {
+	return &UpdateActivityHandler{useCase: uc}
+}
+
+// Handle updates an activity.
+// @Summary      Update activity
+// @Description  Updates the fields sent in the body. The start date must stay before the end date, new dates must be within the event, the activity cannot overlap another one in the same track and the name must stay unique in the event. A capacity of 0 removes the registration limit. Activities of completed events cannot be edited. Admin only.
+// @Tags         Activities
+// @Accept       json
+// @Produce      json
+// @Param        activity_id  path      string                 true  "Activity ID"
+// @Param        request      body      UpdateActivityRequest  true  "Fields to update"
+// @Success      200   {object}  ActivityResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid request body, inconsistent dates, overlapping track or duplicate name"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Activity not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Event is completed"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /activities/{activity_id} [patch]
+func (h *UpdateActivityHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req UpdateActivityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input := &updateactivity.Input{
+		UserID:      middleware.GetUserID(r.Context()),
+		ActivityID:  chi.URLParam(r, "activity_id"),
+		Name:        req.Name,
+		Description: req.Description,
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		Track:       req.Track,
+		Capacity:    req.Capacity,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		respondEventChangeError(w, err)
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, activityToResponse(output.Activity))
+}
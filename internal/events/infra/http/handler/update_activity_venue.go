@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	updateactivityvenue "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_activity_venue"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Response DTOs
+type ActivityVenueResponse struct {
+	ActivityID string         `json:"activity_id"`
+	Venue      *VenueResponse `json:"venue"`
+}
+
+// Handler
+type UpdateActivityVenueHandler struct {
+	useCase *updateactivityvenue.UseCase
+}
+
+func NewUpdateActivityVenueHandler(uc *updateactivityvenue.UseCase) *UpdateActivityVenueHandler {
+	return &UpdateActivityVenueHandler{useCase: uc}
+}
+
+// Handle sets the venue of an activity.
+// @Summary      Set activity venue
+// @Description  Sets the venue of an activity, overriding the event venue. Check-ins must be sent from within radius_meters of the venue coordinates. Admin only.
+// @Tags         Activities
+// @Accept       json
+// @Produce      json
+// @Param        activity_id  path      string        true  "Activity ID"
+// @Param        request      body      VenueRequest  true  "Venue"
+// @Success      200   {object}  ActivityVenueResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid request body or venue"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Activity not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /activities/{activity_id}/venue [put]
+func (h *UpdateActivityVenueHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req VenueRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.execute(w, r, venueRequestToParams(&req))
+}
+
+// HandleDelete removes the venue of an activity.
+// @Summary      Remove activity venue
+// @Description  Removes the venue of an activity; check-ins then use the event venue, if any. Admin only.
+// @Tags         Activities
+// @Produce      json
+// @Param        activity_id  path      string  true  "Activity ID"
+// @Success      200   {object}  ActivityVenueResponse
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Activity not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /activities/{activity_id}/venue [delete]
+func (h *UpdateActivityVenueHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	h.execute(w, r, nil)
+}
+
+func (h *UpdateActivityVenueHandler) execute(w http.ResponseWriter, r *http.Request, venue *entity.NewVenueParams) {
+	input := &updateactivityvenue.Input{
+		UserID:     middleware.GetUserID(r.Context()),
+		ActivityID: chi.URLParam(r, "activity_id"),
+		Venue:      venue,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidVenue) {
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		switch err.Error() {
+		case "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case "activity not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, ActivityVenueResponse{
+		ActivityID: output.Activity.ID,
+		Venue:      venueToResponse(output.Activity.Venue),
+	})
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	updatecertificatesettings "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_certificate_settings"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Request DTOs
+type UpdateCertificateSettingsRequest struct {
+	CertificateMode     *string  `json:"certificate_mode,omitempty" validate:"omitempty,oneof=per_activity consolidated both"`
+	AttendanceRule      *string  `json:"attendance_rule,omitempty" validate:"omitempty,oneof=none min_activities min_percentage min_hours"`
+	AttendanceThreshold *float64 `json:"attendance_threshold,omitempty" validate:"omitempty,gte=0"`
+	WorkloadSource      *string  `json:"workload_source,omitempty" validate:"omitempty,oneof=scheduled attended"`
+}
+
+// Response DTOs
+type CertificateSettingsResponse struct {
+	EventID             string  `json:"event_id"`
+	CertificateMode     string  `json:"certificate_mode"`
+	AttendanceRule      string  `json:"attendance_rule"`
+	AttendanceThreshold float64 `json:"attendance_threshold"`
+	WorkloadSource      string  `json:"workload_source"`
+}
+
+// Handler
+type UpdateCertificateSettingsHandler struct {
+	useCase *updatecertificatesettings.UseCase
+}
+
+func NewUpdateCertificateSettingsHandler(uc *updatecertificatesettings.UseCase) *UpdateCertificateSettingsHandler {
+	return &UpdateCertificateSettingsHandler{useCase: uc}
+}
+
+// Handle updates how certificates are issued for an event.
+// @Summary      Update certificate settings
+// @Description  Updates the certificate settings of an event. certificate_mode: per_activity (one certificate per attended activity), consolidated (one certificate per participant summing the workload of all attended activities) or both. attendance_rule: none, min_activities, min_percentage or min_hours, with attendance_threshold as the number of activities, percentage (0-100) or hours a participant must reach to receive certificates. workload_source: scheduled (activity start to end) or attended (time between check-in and check-out; check-ins without a check-out count as zero). Omitted fields are kept. Admin only.
+// @Tags         Certificates
+// @Accept       json
+// @Produce      json
+// @Param        event_id  path      string                            true  "Event ID"
+// @Param        request   body      UpdateCertificateSettingsRequest  true  "Certificate settings"
+// @Success      200   {object}  CertificateSettingsResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid request body or attendance rule"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/certificate-settings [patch]
+func (h *UpdateCertificateSettingsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req UpdateCertificateSettingsRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input := updateCertificateSettingsRequestToInput(&req, middleware.GetUserID(r.Context()), chi.URLParam(r, "event_id"))
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidAttendanceRule) {
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		switch err.Error() {
+		case "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case "event not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		case "invalid certificate mode", "invalid workload source":
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, eventToCertificateSettingsResponse(output.Event))
+}
+
+// Mappers
+func updateCertificateSettingsRequestToInput(req *UpdateCertificateSettingsRequest, userID, eventID string) *updatecertificatesettings.Input {
+	var mode *entity.CertificateMode
+	if req.CertificateMode != nil {
+		m := entity.CertificateMode(*req.CertificateMode)
+		mode = &m
+	}
+
+	var rule *entity.AttendanceRule
+	if req.AttendanceRule != nil {
+		r := entity.AttendanceRule(*req.AttendanceRule)
+		rule = &r
+	}
+
+	var source *entity.WorkloadSource
+	if req.WorkloadSource != nil {
+		s := entity.WorkloadSource(*req.WorkloadSource)
+		source = &s
+	}
+
+	return &updatecertificatesettings.Input{
+		UserID:              userID,
+		EventID:             eventID,
+		CertificateMode:     mode,
+		AttendanceRule:      rule,
+		AttendanceThreshold: req.AttendanceThreshold,
+		WorkloadSource:      source,
+	}
+}
+
+func eventToCertificateSettingsResponse(event *entity.Event) CertificateSettingsResponse {
+	return CertificateSettingsResponse{
+		EventID:             event.ID,
+		CertificateMode:     string(event.CertificateMode),
+		AttendanceRule:      string(event.AttendanceRule),
+		AttendanceThreshold: event.AttendanceThreshold,
+		WorkloadSource:      string(event.WorkloadSource),
+	}
+}
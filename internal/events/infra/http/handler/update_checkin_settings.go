@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	updatecheckinsettings "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_checkin_settings"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Request DTOs
+type UpdateCheckInSettingsRequest struct {
+	TokenRequired      *bool `json:"token_required,omitempty"`
+	TokenPeriodSeconds *int  `json:"token_period_seconds,omitempty" validate:"omitempty,min=10,max=300"`
+	WindowEnabled      *bool `json:"window_enabled,omitempty"`
+	OpensBeforeMinutes *int  `json:"opens_before_minutes,omitempty" validate:"omitempty,min=0,max=1440"`
+	ClosesAfterMinutes *int  `json:"closes_after_minutes,omitempty" validate:"omitempty,min=0,max=1440"`
+}
+
+// Response DTOs
+type CheckInSettingsResponse struct {
+	ActivityID         string `json:"activity_id"`
+	TokenRequired      bool   `json:"token_required"`
+	TokenPeriodSeconds int    `json:"token_period_seconds"`
+	WindowEnabled      bool   `json:"window_enabled"`
+	OpensBeforeMinutes int    `json:"opens_before_minutes"`
+	ClosesAfterMinutes int    `json:"closes_after_minutes"`
+}
+
+// Handler
+type UpdateCheckInSettingsHandler struct {
+	useCase *updatecheckinsettings.UseCase
+}
+
+func NewUpdateCheckInSettingsHandler(uc *updatecheckinsettings.UseCase) *UpdateCheckInSettingsHandler {
+	return &UpdateCheckInSettingsHandler{useCase: uc}
+}
+
+// Handle updates the check-in settings of an activity.
+// @Summary      Update check-in settings
+// @Description  Updates how participants check in to an activity. token_required: check-in requires the rotating token shown on the activity QR code. token_period_seconds: how often the token rotates (10-300). window_enabled: check-in is only accepted between opens_before_minutes before the activity start and closes_after_minutes after its end (0-1440). Omitted fields are kept. Admin only.
+// @Tags         CheckIn
+// @Accept       json
+// @Produce      json
+// @Param        activity_id  path      string                        true  "Activity ID"
+// @Param        request      body      UpdateCheckInSettingsRequest  true  "Check-in settings"
+// @Success      200   {object}  CheckInSettingsResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid request body or settings"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Activity not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /activities/{activity_id}/checkin-settings [patch]
+func (h *UpdateCheckInSettingsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req UpdateCheckInSettingsRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input := updateCheckInSettingsRequestToInput(&req, middleware.GetUserID(r.Context()), chi.URLParam(r, "activity_id"))
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidCheckInSettings) {
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		switch err.Error() {
+		case "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case "activity not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, activityToCheckInSettingsResponse(output.Activity))
+}
+
+// Mappers
+func updateCheckInSettingsRequestToInput(req *UpdateCheckInSettingsRequest, userID, activityID string) *updatecheckinsettings.Input {
+	return &updatecheckinsettings.Input{
+		UserID:             userID,
+		ActivityID:         activityID,
+		TokenRequired:      req.TokenRequired,
+		TokenPeriodSeconds: req.TokenPeriodSeconds,
+		WindowEnabled:      req.WindowEnabled,
+		OpensBeforeMinutes: req.OpensBeforeMinutes,
+		ClosesAfterMinutes: req.ClosesAfterMinutes,
+	}
+}
+
+func activityToCheckInSettingsResponse(activity *entity.Activity) CheckInSettingsResponse {
+	return CheckInSettingsResponse{
+		ActivityID:         activity.ID,
+		TokenRequired:      activity.CheckInTokenRequired,
+		TokenPeriodSeconds: activity.CheckInTokenPeriodSeconds,
+		WindowEnabled:      activity.CheckInWindowEnabled,
+		OpensBeforeMinutes: activity.CheckInOpensBeforeMinutes,
+		ClosesAfterMinutes: activity.CheckInClosesAfterMinutes,
+	}
+}
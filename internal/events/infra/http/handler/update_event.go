@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	updateevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_event"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Request DTOs
+type UpdateEventRequest struct {
+	Name           *string    `json:"name,omitempty" validate:"omitempty,min=3,max=255"`
+	AllowedDomains *[]string  `json:"allowed_domains,omitempty" validate:"omitempty,dive,fqdn"`
+	Description    *string    `json:"description,omitempty" validate:"omitempty,max=500"`
+	StartDate      *time.Time `json:"start_date,omitempty"`
+	EndDate        *time.Time `json:"end_date,omitempty"`
+	// quando true, só participantes inscritos fazem check-in
+	RegistrationRequired *bool `json:"registration_required,omitempty"`
+}
+
+// Handler
+type UpdateEventHandler struct {
+	useCase *updateevent.UseCase
+}
+
+func NewUpdateEventHandler(uc *updateevent.UseCase) *UpdateEventHandler {
+	return &UpdateEventHandler{useCase: uc}
+}
+
+// Handle updates an event.
+// @Summary      Update event
+// @Description  Updates the fields sent in the body. The start date must stay before the end date and, when the dates change, all activities must stay within the event. With registration_required, only registered participants can check in. Completed events cannot be edited. Admin only.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Param        event_id  path      string              true  "Event ID"
+// @Param        request   body      UpdateEventRequest  true  "Fields to update"
+// @Success      200   {object}  EventResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid request body or inconsistent dates"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      409   {object}  lib.ErrorResponse  "Event is completed"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id} [patch]
+func (h *UpdateEventHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req UpdateEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input := &updateevent.Input{
+		UserID:               middleware.GetUserID(r.Context()),
+		EventID:              chi.URLParam(r, "event_id"),
+		Name:                 req.Name,
+		AllowedDomains:       req.AllowedDomains,
+		Description:          req.Description,
+		StartDate:            req.StartDate,
+		EndDate:              req.EndDate,
+		RegistrationRequired: req.RegistrationRequired,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		respondEventChangeError(w, err)
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, eventToResponse(output.Event))
+}
+
+// respondEventChangeError mapeia os erros de alteração e remoção de eventos e atividades
+func respondEventChangeError(w http.ResponseWriter, err error) {
+	if errors.Is(err, entity.ErrEventNotEditable) {
+		lib.RespondError(w, http.StatusConflict, err.Error())
+		return
+	}
+	if errors.Is(err, entity.ErrActivityTrackOverlap) {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	switch err.Error() {
+	case "user is not an admin":
+		lib.RespondError(w, http.StatusForbidden, err.Error())
+	case "event not found", "activity not found":
+		lib.RespondError(w, http.StatusNotFound, err.Error())
+	case "start date must be before end date",
+		"event dates must include all of its activities",
+		"activity must be within the event dates",
+		"activity with the same name already exists for this event":
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+	case "event has check-ins", "event has issued certificates",
+		"activity has check-ins", "activity has issued certificates":
+		lib.RespondError(w, http.StatusConflict, err.Error())
+	default:
+		lib.RespondError(w, http.StatusInternalServerError, err.Error())
+	}
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	updateeventvenue "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_event_venue"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Request DTOs
+type VenueRequest struct {
+	Name         *string  `json:"name,omitempty" validate:"omitempty,max=255"`
+	Latitude     *float64 `json:"latitude" validate:"required,latitude"`
+	Longitude    *float64 `json:"longitude" validate:"required,longitude"`
+	RadiusMeters int      `json:"radius_meters" validate:"required,min=10,max=50000"`
+}
+
+// Response DTOs
+type VenueResponse struct {
+	Name         *string `json:"name,omitempty"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+	RadiusMeters int     `json:"radius_meters"`
+}
+
+type EventVenueResponse struct {
+	EventID string         `json:"event_id"`
+	Venue   *VenueResponse `json:"venue"`
+}
+
+// Handler
+type UpdateEventVenueHandler struct {
+	useCase *updateeventvenue.UseCase
+}
+
+func NewUpdateEventVenueHandler(uc *updateeventvenue.UseCase) *UpdateEventVenueHandler {
+	return &UpdateEventVenueHandler{useCase: uc}
+}
+
+// Handle sets the venue of an event.
+// @Summary      Set event venue
+// @Description  Sets the venue of an event. Check-ins to activities without their own venue must be sent from within radius_meters of the venue coordinates. Admin only.
+// @Tags         Events
+// @Accept       json
+// @Produce      json
+// @Param        event_id  path      string        true  "Event ID"
+// @Param        request   body      VenueRequest  true  "Venue"
+// @Success      200   {object}  EventVenueResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid request body or venue"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/venue [put]
+func (h *UpdateEventVenueHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req VenueRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.execute(w, r, venueRequestToParams(&req))
+}
+
+// HandleDelete removes the venue of an event.
+// @Summary      Remove event venue
+// @Description  Removes the venue of an event, disabling the geofence for activities without their own venue. Admin only.
+// @Tags         Events
+// @Produce      json
+// @Param        event_id  path      string  true  "Event ID"
+// @Success      200   {object}  EventVenueResponse
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/venue [delete]
+func (h *UpdateEventVenueHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	h.execute(w, r, nil)
+}
+
+func (h *UpdateEventVenueHandler) execute(w http.ResponseWriter, r *http.Request, venue *entity.NewVenueParams) {
+	input := &updateeventvenue.Input{
+		UserID:  middleware.GetUserID(r.Context()),
+		EventID: chi.URLParam(r, "event_id"),
+		Venue:   venue,
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidVenue) {
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		switch err.Error() {
+		case "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case "event not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, EventVenueResponse{
+		EventID: output.Event.ID,
+		Venue:   venueToResponse(output.Event.Venue),
+	})
+}
+
+// Mappers
+func venueRequestToParams(req *VenueRequest) *entity.NewVenueParams {
+	if req == nil {
+		return nil
+	}
+
+	return &entity.NewVenueParams{
+		Name:         req.Name,
+		Latitude:     *req.Latitude,
+		Longitude:    *req.Longitude,
+		RadiusMeters: req.RadiusMeters,
+	}
+}
+
+func venueToResponse(venue entity.Venue) *VenueResponse {
+	if !venue.HasVenue() {
+		return nil
+	}
+
+	return &VenueResponse{
+		Name:         venue.VenueName,
+		Latitude:     *venue.VenueLatitude,
+		Longitude:    *venue.VenueLongitude,
+		RadiusMeters: *venue.VenueRadiusMeters,
+	}
+}
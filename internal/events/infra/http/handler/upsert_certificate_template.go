@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	upsertcertificatetemplate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/upsert_certificate_template"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/go-chi/chi/v5"
+)
+
+// Request DTOs
+// Imagens são enviadas em base64 (PNG ou JPEG, até 1MB): omitir o campo mantém a imagem atual
+// e enviar uma string vazia remove a imagem
+type CertificateSignatoryRequest struct {
+	Name           string  `json:"name" validate:"required,max=100"`
+	Title          string  `json:"title" validate:"required,max=100"`
+	SignatureImage *string `json:"signature_image,omitempty"`
+}
+
+type UpsertCertificateTemplateRequest struct {
+	Title          string                        `json:"title" validate:"omitempty,max=60"`
+	BodyText       string                        `json:"body_text" validate:"omitempty,max=1000"`
+	Signatories    []CertificateSignatoryRequest `json:"signatories" validate:"max=3,dive"`
+	Logo           *string                       `json:"logo,omitempty"`
+	PrimaryColor   string                        `json:"primary_color" validate:"omitempty,hexcolor,len=7"`
+	AccentColor    string                        `json:"accent_color" validate:"omitempty,hexcolor,len=7"`
+	SecondaryColor string                        `json:"secondary_color" validate:"omitempty,hexcolor,len=7"`
+}
+
+// Handler
+type UpsertCertificateTemplateHandler struct {
+	useCase *upsertcertificatetemplate.UseCase
+}
+
+func NewUpsertCertificateTemplateHandler(uc *upsertcertificatetemplate.UseCase) *UpsertCertificateTemplateHandler {
+	return &UpsertCertificateTemplateHandler{useCase: uc}
+}
+
+// Handle creates or replaces the certificate template of an event.
+// @Summary      Save certificate template
+// @Description  Creates or replaces the certificate template of an event: title, body text with placeholders ({{participant_name}}, {{event_name}}, {{activity_name}}, {{event_date}}, {{workload}}, {{issue_date}}), up to 3 signatories, logo and colors. Images are base64 PNG/JPEG up to 1MB; omit an image to keep the current one or send an empty string to remove it. Admin only.
+// @Tags         Certificates
+// @Accept       json
+// @Produce      json
+// @Param        event_id  path      string                            true  "Event ID"
+// @Param        request   body      UpsertCertificateTemplateRequest  true  "Certificate template"
+// @Success      200   {object}  CertificateTemplateResponse
+// @Failure      400   {object}  lib.ErrorResponse  "Invalid request body, placeholder or image"
+// @Failure      403   {object}  lib.ErrorResponse  "User is not an admin"
+// @Failure      404   {object}  lib.ErrorResponse  "Event not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /events/{event_id}/certificate-template [put]
+func (h *UpsertCertificateTemplateHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req UpsertCertificateTemplateRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	input, err := upsertCertificateTemplateRequestToInput(&req, middleware.GetUserID(r.Context()), chi.URLParam(r, "event_id"))
+	if err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		switch {
+		case err.Error() == "user is not an admin":
+			lib.RespondError(w, http.StatusForbidden, err.Error())
+		case err.Error() == "event not found":
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, upsertcertificatetemplate.ErrInvalidImage),
+			errors.Is(err, entity.ErrInvalidCertificatePlaceholder),
+			errors.Is(err, entity.ErrTooManyCertificateSignatories):
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, certificateTemplateToResponse(output.Template))
+}
+
+// Mappers
+func upsertCertificateTemplateRequestToInput(req *UpsertCertificateTemplateRequest, userID, eventID string) (*upsertcertificatetemplate.Input, error) {
+	logo, err := decodeImageInput(req.Logo)
+	if err != nil {
+		return nil, err
+	}
+
+	signatories := make([]upsertcertificatetemplate.SignatoryInput, len(req.Signatories))
+	for i, s := range req.Signatories {
+		signature, err := decodeImageInput(s.SignatureImage)
+		if err != nil {
+			return nil, err
+		}
+
+		signatories[i] = upsertcertificatetemplate.SignatoryInput{
+			Name:           s.Name,
+			Title:          s.Title,
+			SignatureImage: signature,
+		}
+	}
+
+	return &upsertcertificatetemplate.Input{
+		UserID:         userID,
+		EventID:        eventID,
+		Title:          req.Title,
+		BodyText:       req.BodyText,
+		Signatories:    signatories,
+		Logo:           logo,
+		PrimaryColor:   req.PrimaryColor,
+		AccentColor:    req.AccentColor,
+		SecondaryColor: req.SecondaryColor,
+	}, nil
+}
+
+func decodeImageInput(encoded *string) (*upsertcertificatetemplate.ImageInput, error) {
+	if encoded == nil {
+		return nil, nil
+	}
+
+	content, err := base64.StdEncoding.DecodeString(*encoded)
+	if err != nil {
+		return nil, errors.New("invalid image: must be base64 encoded")
+	}
+
+	return &upsertcertificatetemplate.ImageInput{Content: content}, nil
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	verifycertificate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/verify_certificate"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/go-chi/chi/v5"
+)
+
+// Response DTOs
+type VerifyCertificateResponse struct {
+	VerificationCode string     `json:"verification_code"`
+	Valid            bool       `json:"valid"`
+	RecipientName    string     `json:"recipient_name"`
+	EventID          string     `json:"event_id"`
+	EventName        string     `json:"event_name"`
+	ActivityID       *string    `json:"activity_id,omitempty"`
+	ActivityName     *string    `json:"activity_name,omitempty"`
+	WorkloadMinutes  int        `json:"workload_minutes"`
+	Workload         string     `json:"workload"`
+	IssuedAt         time.Time  `json:"issued_at"`
+	RevokedAt        *time.Time `json:"revoked_at,omitempty"`
+	RevokedReason    *string    `json:"revoked_reason,omitempty"`
+}
+
+// Handler
+type VerifyCertificateHandler struct {
+	useCase *verifycertificate.UseCase
+}
+
+func NewVerifyCertificateHandler(uc *verifycertificate.UseCase) *VerifyCertificateHandler {
+	return &VerifyCertificateHandler{useCase: uc}
+}
+
+// Handle verifies a certificate by its verification code.
+// @Summary      Verify certificate
+// @Description  Public endpoint that checks a certificate verification code and returns who earned it, for which event and activity, the workload and whether it is still valid.
+// @Tags         Certificates
+// @Produce      json
+// @Param        code  path      string  true  "Verification code"
+// @Success      200   {object}  VerifyCertificateResponse
+// @Failure      404   {object}  lib.ErrorResponse  "Certificate not found"
+// @Failure      500   {object}  lib.ErrorResponse  "Internal server error"
+// @Router       /certificates/verify/{code} [get]
+func (h *VerifyCertificateHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	input := &verifycertificate.Input{
+		Code: chi.URLParam(r, "code"),
+	}
+
+	output, err := h.useCase.Execute(r.Context(), input)
+	if err != nil {
+		if err.Error() == "certificate not found" {
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, certificateToVerifyResponse(output.Certificate))
+}
+
+// Mappers
+func certificateToVerifyResponse(certificate *entity.Certificate) VerifyCertificateResponse {
+	return VerifyCertificateResponse{
+		VerificationCode: certificate.VerificationCode,
+		Valid:            certificate.IsValid(),
+		RecipientName:    certificate.RecipientName,
+		EventID:          certificate.EventID,
+		EventName:        certificate.EventName,
+		ActivityID:       certificate.ActivityID,
+		ActivityName:     certificate.ActivityName,
+		WorkloadMinutes:  certificate.WorkloadMinutes,
+		Workload:         entity.FormatWorkload(certificate.Workload()),
+		IssuedAt:         certificate.IssuedAt,
+		RevokedAt:        certificate.RevokedAt,
+		RevokedReason:    certificate.RevokedReason,
+	}
+}
@@ -2,44 +2,135 @@ package http
 
 import (
 	"github.com/gabrielmatsan/checkin-gate/internal/config"
+	cancelevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/cancel_event"
+	cancelregistration "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/cancel_registration"
 	checkinactivity "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/checkin_activity"
+	checkoutactivity "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/checkout_activity"
+	confirmregistration "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/confirm_registration"
 	createactivities "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/create_activities"
 	createevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/create_event"
+	deleteactivity "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/delete_activity"
+	deletecertificatetemplate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/delete_certificate_template"
+	deleteevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/delete_event"
+	downloadcertificate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/download_certificate"
 	finishevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/finish_event"
+	getcertificatetemplate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/get_certificate_template"
+	getcheckintoken "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/get_checkin_token"
 	geteventdetails "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/get_event_details"
 	geteventwithactivities "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/get_event_with_activities"
+	listcertificatejobs "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_certificate_jobs"
+	listcheckinrejections "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_checkin_rejections"
+	listdeadletterjobs "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_dead_letter_jobs"
+	listevents "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_events"
+	listusercertificates "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_user_certificates"
+	listusercheckins "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/list_user_checkins"
+	previewcertificatetemplate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/preview_certificate_template"
+	processwaitlist "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/process_waitlist"
+	publishevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/publish_event"
+	registerforevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/register_for_event"
+	registermanualcheckin "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/register_manual_checkin"
+	reopenevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/reopen_event"
+	replaydeadletterjob "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/replay_dead_letter_job"
+	resendcertificatejob "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/resend_certificate_job"
+	resendfailedcertificatejobs "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/resend_failed_certificate_jobs"
+	revokecheckin "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/revoke_checkin"
+	updateactivity "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_activity"
+	updateactivityvenue "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_activity_venue"
+	updatecertificatesettings "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_certificate_settings"
+	updatecheckinsettings "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_checkin_settings"
+	updateevent "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_event"
+	updateeventvenue "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/update_event_venue"
+	upsertcertificatetemplate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/upsert_certificate_template"
+	verifycertificate "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/verify_certificate"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
 	"github.com/gabrielmatsan/checkin-gate/internal/events/infra/http/handler"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/infra/pdf"
 	"github.com/gabrielmatsan/checkin-gate/internal/events/infra/persistence"
-	infraqueue "github.com/gabrielmatsan/checkin-gate/internal/events/infra/queue"
 	eventsvc "github.com/gabrielmatsan/checkin-gate/internal/events/infra/service"
 	identitypersistence "github.com/gabrielmatsan/checkin-gate/internal/identity/infra/persistence"
 	"github.com/gabrielmatsan/checkin-gate/internal/identity/infra/service"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/mail"
 	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/storage"
 	"github.com/go-chi/chi/v5"
 	"github.com/jmoiron/sqlx"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
-func RegisterEventsRoutes(r chi.Router, db *sqlx.DB, redisClient *redis.Client, cfg *config.Config, logger *zap.Logger) {
+func RegisterEventsRoutes(r chi.Router, db *sqlx.DB, redisClient *redis.Client, blobStorage storage.BlobStorage, certificateQueue queue.CertificateQueue, cfg *config.Config, logger *zap.Logger) {
 	jwtService := service.NewJWTService(cfg.JWTSecret)
 
 	eventRepo := persistence.NewPostgresEventRepository(db)
 	activityRepo := persistence.NewPostgresActivityRepository(db)
 	checkInRepo := persistence.NewPostgresCheckInRepository(db)
+	certificateRepo := persistence.NewPostgresCertificateRepository(db)
+	certificateJobRepo := persistence.NewPostgresCertificateJobRepository(db)
+	certificateTemplateRepo := persistence.NewPostgresCertificateTemplateRepository(db)
+	checkInRejectionRepo := persistence.NewPostgresCheckInRejectionRepository(db)
+	registrationRepo := persistence.NewPostgresRegistrationRepository(db)
 	userRepo := identitypersistence.NewPostgresUserRepository(db)
 
-	eventsTxProvider := persistence.NewPostgresTransactionProvider(db)
+	eventsTxProvider := persistence.NewPostgresTransactionProvider(db).WithCertificateQueue(certificateQueue)
 
 	userAuthSvc := eventsvc.NewUserAuthorizationAdapter(userRepo)
-	certificateQueue := infraqueue.NewRedisCertificateQueue(redisClient)
+	certificateGenerator := pdf.NewMarotoGenerator()
+	certificateTemplateLoader := pdf.NewTemplateLoader(certificateTemplateRepo, blobStorage)
+
+	checkInTokenSecret := cfg.CheckInTokenSecret
+	if checkInTokenSecret == "" {
+		checkInTokenSecret = cfg.JWTSecret
+	}
+	checkInTokenSvc := eventsvc.NewHMACCheckInTokenService(checkInTokenSecret)
+
+	var emailService mail.EmailService = mail.NewNoopService(logger)
+	if cfg.ResendKey != "" {
+		emailService = mail.NewResendService(cfg.ResendKey, cfg.ResendFrom)
+	}
+	attendeeNotifier := eventsvc.NewEmailAttendeeNotifier(emailService)
 
 	createEvent := createevent.NewUseCase(eventRepo, userAuthSvc)
 	createActivities := createactivities.NewUseCase(activityRepo, eventRepo, userAuthSvc)
 	getEventWithActivities := geteventwithactivities.NewUseCase(eventRepo, activityRepo)
 	getEventDetails := geteventdetails.NewUseCase(eventRepo)
-	checkInActivity := checkinactivity.NewUseCase(checkInRepo, activityRepo, eventRepo, userAuthSvc)
-	finishEvent := finishevent.NewUseCase(eventsTxProvider, eventRepo, activityRepo, checkInRepo, userAuthSvc, certificateQueue)
+	checkInActivity := checkinactivity.NewUseCase(checkInRepo, activityRepo, eventRepo, userAuthSvc, checkInTokenSvc, checkInRejectionRepo, registrationRepo)
+	checkOutActivity := checkoutactivity.NewUseCase(checkInRepo, activityRepo)
+	registerManualCheckIn := registermanualcheckin.NewUseCase(checkInActivity, userAuthSvc)
+	revokeCheckIn := revokecheckin.NewUseCase(eventsTxProvider, checkInRepo, activityRepo, userAuthSvc)
+	registerForEvent := registerforevent.NewUseCase(eventsTxProvider, eventRepo, userAuthSvc)
+	processWaitlist := processwaitlist.NewUseCase(eventsTxProvider, userAuthSvc, attendeeNotifier, cfg.WaitlistConfirmationWindow)
+	cancelRegistration := cancelregistration.NewUseCase(eventsTxProvider, registrationRepo, userAuthSvc, processWaitlist, logger)
+	confirmRegistration := confirmregistration.NewUseCase(eventsTxProvider)
+	updateCheckInSettings := updatecheckinsettings.NewUseCase(activityRepo, userAuthSvc)
+	updateEventVenue := updateeventvenue.NewUseCase(eventRepo, userAuthSvc)
+	updateActivityVenue := updateactivityvenue.NewUseCase(activityRepo, userAuthSvc)
+	listCheckInRejections := listcheckinrejections.NewUseCase(checkInRejectionRepo, eventRepo, userAuthSvc)
+	getCheckInToken := getcheckintoken.NewUseCase(activityRepo, checkInTokenSvc, userAuthSvc, cfg.CheckInAppURL)
+	listEvents := listevents.NewUseCase(eventRepo, userAuthSvc)
+	updateEvent := updateevent.NewUseCase(eventRepo, activityRepo, userAuthSvc)
+	deleteEvent := deleteevent.NewUseCase(eventsTxProvider, eventRepo, activityRepo, checkInRepo, certificateRepo, userAuthSvc)
+	updateActivity := updateactivity.NewUseCase(activityRepo, userAuthSvc)
+	deleteActivity := deleteactivity.NewUseCase(eventsTxProvider, activityRepo, checkInRepo, certificateRepo, userAuthSvc)
+	publishEvent := publishevent.NewUseCase(eventRepo, userAuthSvc)
+	cancelEvent := cancelevent.NewUseCase(eventRepo, activityRepo, checkInRepo, userAuthSvc, attendeeNotifier, logger)
+	reopenEvent := reopenevent.NewUseCase(eventRepo, userAuthSvc)
+	finishEvent := finishevent.NewUseCase(eventsTxProvider, eventRepo, activityRepo, checkInRepo, userAuthSvc)
+	listDeadLetterJobs := listdeadletterjobs.NewUseCase(certificateQueue, userAuthSvc)
+	replayDeadLetterJob := replaydeadletterjob.NewUseCase(certificateQueue, certificateJobRepo, userAuthSvc)
+	listCertificateJobs := listcertificatejobs.NewUseCase(certificateJobRepo, eventRepo, userAuthSvc)
+	resendCertificateJob := resendcertificatejob.NewUseCase(certificateJobRepo, certificateRepo, certificateQueue, userAuthSvc)
+	resendFailedCertificateJobs := resendfailedcertificatejobs.NewUseCase(certificateJobRepo, certificateRepo, eventRepo, certificateQueue, userAuthSvc)
+	verifyCertificate := verifycertificate.NewUseCase(certificateRepo)
+	listUserCertificates := listusercertificates.NewUseCase(certificateRepo)
+	listUserCheckIns := listusercheckins.NewUseCase(checkInRepo)
+	downloadCertificate := downloadcertificate.NewUseCase(certificateRepo, blobStorage, userAuthSvc)
+	getCertificateTemplate := getcertificatetemplate.NewUseCase(certificateTemplateRepo, eventRepo, userAuthSvc)
+	upsertCertificateTemplate := upsertcertificatetemplate.NewUseCase(certificateTemplateRepo, eventRepo, blobStorage, userAuthSvc)
+	deleteCertificateTemplate := deletecertificatetemplate.NewUseCase(certificateTemplateRepo, blobStorage, userAuthSvc)
+	updateCertificateSettings := updatecertificatesettings.NewUseCase(eventRepo, userAuthSvc)
+	previewCertificateTemplate := previewcertificatetemplate.NewUseCase(eventRepo, certificateTemplateLoader, certificateGenerator, userAuthSvc, cfg.PublicBaseURL)
+
+	idempotency := middleware.Idempotency(redisClient, cfg.IdempotencyTTL, cfg.IdempotencyLockTTL)
 
 	// Create individual handlers
 	createEventHandler := handler.NewCreateEventHandler(logger, createEvent)
@@ -47,26 +138,116 @@ func RegisterEventsRoutes(r chi.Router, db *sqlx.DB, redisClient *redis.Client,
 	getEventWithActivitiesHandler := handler.NewGetEventWithActivitiesHandler(getEventWithActivities)
 	getEventDetailsHandler := handler.NewGetEventDetailsHandler(getEventDetails)
 	checkInActivityHandler := handler.NewCheckInActivityHandler(checkInActivity)
+	checkOutActivityHandler := handler.NewCheckOutActivityHandler(checkOutActivity)
+	registerManualCheckInHandler := handler.NewRegisterManualCheckInHandler(registerManualCheckIn)
+	revokeCheckInHandler := handler.NewRevokeCheckInHandler(revokeCheckIn)
+	updateCheckInSettingsHandler := handler.NewUpdateCheckInSettingsHandler(updateCheckInSettings)
+	updateEventVenueHandler := handler.NewUpdateEventVenueHandler(updateEventVenue)
+	updateActivityVenueHandler := handler.NewUpdateActivityVenueHandler(updateActivityVenue)
+	listCheckInRejectionsHandler := handler.NewListCheckInRejectionsHandler(listCheckInRejections)
+	getCheckInTokenHandler := handler.NewGetCheckInTokenHandler(getCheckInToken)
+	listEventsHandler := handler.NewListEventsHandler(listEvents)
+	updateEventHandler := handler.NewUpdateEventHandler(updateEvent)
+	deleteEventHandler := handler.NewDeleteEventHandler(deleteEvent)
+	updateActivityHandler := handler.NewUpdateActivityHandler(updateActivity)
+	deleteActivityHandler := handler.NewDeleteActivityHandler(deleteActivity)
+	publishEventHandler := handler.NewPublishEventHandler(publishEvent)
+	cancelEventHandler := handler.NewCancelEventHandler(cancelEvent)
+	reopenEventHandler := handler.NewReopenEventHandler(reopenEvent)
 	finishEventHandler := handler.NewFinishEventHandler(finishEvent)
+	listDeadLetterJobsHandler := handler.NewListDeadLetterJobsHandler(listDeadLetterJobs)
+	replayDeadLetterJobHandler := handler.NewReplayDeadLetterJobHandler(replayDeadLetterJob)
+	listCertificateJobsHandler := handler.NewListCertificateJobsHandler(listCertificateJobs)
+	resendCertificateJobHandler := handler.NewResendCertificateJobHandler(resendCertificateJob)
+	resendFailedCertificateJobsHandler := handler.NewResendFailedCertificateJobsHandler(resendFailedCertificateJobs)
+	verifyCertificateHandler := handler.NewVerifyCertificateHandler(verifyCertificate)
+	listUserCertificatesHandler := handler.NewListUserCertificatesHandler(listUserCertificates)
+	listUserCheckInsHandler := handler.NewListUserCheckInsHandler(listUserCheckIns)
+	downloadCertificateHandler := handler.NewDownloadCertificateHandler(downloadCertificate)
+	getCertificateTemplateHandler := handler.NewGetCertificateTemplateHandler(getCertificateTemplate)
+	upsertCertificateTemplateHandler := handler.NewUpsertCertificateTemplateHandler(upsertCertificateTemplate)
+	deleteCertificateTemplateHandler := handler.NewDeleteCertificateTemplateHandler(deleteCertificateTemplate)
+	updateCertificateSettingsHandler := handler.NewUpdateCertificateSettingsHandler(updateCertificateSettings)
+	previewCertificateTemplateHandler := handler.NewPreviewCertificateTemplateHandler(previewCertificateTemplate)
+	registerForEventHandler := handler.NewRegisterForEventHandler(registerForEvent)
+	cancelRegistrationHandler := handler.NewCancelRegistrationHandler(cancelRegistration)
+	confirmRegistrationHandler := handler.NewConfirmRegistrationHandler(confirmRegistration)
 
 	r.Route("/events", func(r chi.Router) {
 		// protected routes
 		r.Group(func(r chi.Router) {
 			r.Use(middleware.Auth(middleware.NewValidateTokenFunc(jwtService.ExtractClaims)))
+			r.Use(idempotency)
 
+			r.Get("/", listEventsHandler.Handle)
 			r.Post("/", createEventHandler.Handle)
 			r.Post("/activities", createActivitiesHandler.Handle)
+			r.Patch("/{event_id}", updateEventHandler.Handle)
+			r.Delete("/{event_id}", deleteEventHandler.Handle)
 			r.Get("/{event_id}/activities", getEventWithActivitiesHandler.Handle)
 			r.Get("/{event_id}/details", getEventDetailsHandler.Handle)
+			r.Post("/{event_id}/publish", publishEventHandler.Handle)
+			r.Post("/{event_id}/cancel", cancelEventHandler.Handle)
+			r.Post("/{event_id}/reopen", reopenEventHandler.Handle)
 			r.Post("/{event_id}/finish", finishEventHandler.Handle)
+			r.Get("/{event_id}/certificates/jobs", listCertificateJobsHandler.Handle)
+			r.Post("/{event_id}/certificates/jobs/resend-failed", resendFailedCertificateJobsHandler.Handle)
+			r.Post("/{event_id}/certificates/jobs/{job_id}/resend", resendCertificateJobHandler.Handle)
+			r.Get("/{event_id}/certificate-template", getCertificateTemplateHandler.Handle)
+			r.Put("/{event_id}/certificate-template", upsertCertificateTemplateHandler.Handle)
+			r.Delete("/{event_id}/certificate-template", deleteCertificateTemplateHandler.Handle)
+			r.Get("/{event_id}/certificate-template/preview", previewCertificateTemplateHandler.Handle)
+			r.Patch("/{event_id}/certificate-settings", updateCertificateSettingsHandler.Handle)
+			r.Put("/{event_id}/venue", updateEventVenueHandler.Handle)
+			r.Delete("/{event_id}/venue", updateEventVenueHandler.HandleDelete)
+			r.Get("/{event_id}/checkin-rejections", listCheckInRejectionsHandler.Handle)
+			r.Post("/{event_id}/registrations", registerForEventHandler.Handle)
+			r.Delete("/{event_id}/registrations/{registration_id}", cancelRegistrationHandler.Handle)
+			r.Post("/{event_id}/registrations/{registration_id}/confirm", confirmRegistrationHandler.Handle)
 		})
 	})
 
 	r.Route("/activities", func(r chi.Router) {
 		r.Group(func(r chi.Router) {
 			r.Use(middleware.Auth(middleware.NewValidateTokenFunc(jwtService.ExtractClaims)))
+			r.Use(idempotency)
 
+			r.Patch("/{activity_id}", updateActivityHandler.Handle)
+			r.Delete("/{activity_id}", deleteActivityHandler.Handle)
 			r.Post("/{activity_id}/checkin", checkInActivityHandler.Handle)
+			r.Post("/{activity_id}/checkout", checkOutActivityHandler.Handle)
+			r.Post("/{activity_id}/checkins", registerManualCheckInHandler.Handle)
+			r.Post("/{activity_id}/checkins/{checkin_id}/revoke", revokeCheckInHandler.Handle)
+			r.Patch("/{activity_id}/checkin-settings", updateCheckInSettingsHandler.Handle)
+			r.Get("/{activity_id}/checkin-token", getCheckInTokenHandler.Handle)
+			r.Get("/{activity_id}/checkin-token/qr", getCheckInTokenHandler.HandleQRCode)
+			r.Put("/{activity_id}/venue", updateActivityVenueHandler.Handle)
+			r.Delete("/{activity_id}/venue", updateActivityVenueHandler.HandleDelete)
+		})
+	})
+
+	r.Route("/certificates", func(r chi.Router) {
+		// public routes
+		r.Get("/verify/{code}", verifyCertificateHandler.Handle)
+
+		// protected routes
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.Auth(middleware.NewValidateTokenFunc(jwtService.ExtractClaims)))
+			r.Use(idempotency)
+
+			r.Get("/dead-letters", listDeadLetterJobsHandler.Handle)
+			r.Post("/dead-letters/{job_id}/replay", replayDeadLetterJobHandler.Handle)
+			r.Get("/{certificate_id}/pdf", downloadCertificateHandler.Handle)
+		})
+	})
+
+	r.Route("/me", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.Auth(middleware.NewValidateTokenFunc(jwtService.ExtractClaims)))
+			r.Use(idempotency)
+
+			r.Get("/certificates", listUserCertificatesHandler.Handle)
+			r.Get("/checkins", listUserCheckInsHandler.Handle)
 		})
 	})
 }
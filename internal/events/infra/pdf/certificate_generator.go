@@ -1,16 +1,56 @@
 package pdf
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
 
 // CertificateData contém os dados para preencher o template do certificado
 type CertificateData struct {
 	RecipientName   string
 	EventName       string
+	ActivityName    string
 	EventDate       string
 	Workload        string
-	DirectorName    string
-	CoordinatorName string
 	CertificateDate string
+	// VerificationCode é impresso no rodapé e VerificationURL é codificada no QR code
+	VerificationCode string
+	VerificationURL  string
+	// Activities lista as atividades do certificado consolidado do evento (verso do certificado)
+	Activities []CertificateActivity
+	Template   CertificateTemplate
+}
+
+type CertificateActivity struct {
+	Name     string
+	Date     string
+	Workload string
+}
+
+// CertificateTemplate define o título, texto, cores, logo e signatários do certificado
+// O BodyText aceita os placeholders definidos em entity (ex: {{participant_name}})
+type CertificateTemplate struct {
+	Title          string
+	BodyText       string
+	Signatories    []Signatory
+	Logo           *Image
+	PrimaryColor   string
+	AccentColor    string
+	SecondaryColor string
+}
+
+type Signatory struct {
+	Name      string
+	Title     string
+	Signature *Image
+}
+
+// Image é uma imagem PNG ou JPEG já carregada em memória
+type Image struct {
+	Content   []byte
+	Extension string
 }
 
 // CertificateGenerator define a interface para geração de certificados em PDF
@@ -18,3 +58,26 @@ type CertificateGenerator interface {
 	// Generate gera um certificado PDF a partir dos dados fornecidos
 	Generate(ctx context.Context, data CertificateData) ([]byte, error)
 }
+
+// FormatDate formata uma data no padrão brasileiro (ex: "5 de março de 2025")
+func FormatDate(t time.Time) string {
+	months := []string{
+		"", "janeiro", "fevereiro", "março", "abril", "maio", "junho",
+		"julho", "agosto", "setembro", "outubro", "novembro", "dezembro",
+	}
+	return fmt.Sprintf("%d de %s de %d", t.Day(), months[t.Month()], t.Year())
+}
+
+// FormatDateRange formata um período (ex: "5 de março de 2025 a 7 de março de 2025")
+// Se início e fim forem no mesmo dia, retorna apenas uma data
+func FormatDateRange(start, end time.Time) string {
+	if end.IsZero() || (start.Year() == end.Year() && start.YearDay() == end.YearDay()) {
+		return FormatDate(start)
+	}
+	return FormatDate(start) + " a " + FormatDate(end)
+}
+
+// VerificationURL monta o link público de verificação do certificado
+func VerificationURL(baseURL, code string) string {
+	return strings.TrimRight(baseURL, "/") + "/certificates/verify/" + code
+}
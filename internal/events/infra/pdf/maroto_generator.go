@@ -2,14 +2,21 @@ package pdf
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
 	"github.com/johnfercher/maroto/v2"
+	"github.com/johnfercher/maroto/v2/pkg/components/code"
 	"github.com/johnfercher/maroto/v2/pkg/components/col"
+	"github.com/johnfercher/maroto/v2/pkg/components/image"
 	"github.com/johnfercher/maroto/v2/pkg/components/line"
+	"github.com/johnfercher/maroto/v2/pkg/components/page"
 	"github.com/johnfercher/maroto/v2/pkg/components/row"
 	"github.com/johnfercher/maroto/v2/pkg/components/text"
 	"github.com/johnfercher/maroto/v2/pkg/config"
 	"github.com/johnfercher/maroto/v2/pkg/consts/align"
+	"github.com/johnfercher/maroto/v2/pkg/consts/extension"
 	"github.com/johnfercher/maroto/v2/pkg/consts/fontstyle"
 	"github.com/johnfercher/maroto/v2/pkg/consts/orientation"
 	"github.com/johnfercher/maroto/v2/pkg/consts/pagesize"
@@ -17,15 +24,27 @@ import (
 	"github.com/johnfercher/maroto/v2/pkg/props"
 )
 
-// Cores do certificado
+// Cores fixas do certificado (textos secundários)
 var (
-	primaryColor   = &props.Color{Red: 26, Green: 26, Blue: 46}    // #1a1a2e
-	goldColor      = &props.Color{Red: 201, Green: 162, Blue: 39}  // #c9a227
-	darkBlueColor  = &props.Color{Red: 15, Green: 52, Blue: 96}    // #0f3460
 	grayColor      = &props.Color{Red: 102, Green: 102, Blue: 102} // #666666
 	lightGrayColor = &props.Color{Red: 153, Green: 153, Blue: 153} // #999999
 )
 
+// palette contém as cores configuráveis do template
+type palette struct {
+	primary   *props.Color
+	accent    *props.Color
+	secondary *props.Color
+}
+
+func newPalette(template CertificateTemplate) palette {
+	return palette{
+		primary:   parseHexColor(template.PrimaryColor, entity.DefaultCertificatePrimaryColor),
+		accent:    parseHexColor(template.AccentColor, entity.DefaultCertificateAccentColor),
+		secondary: parseHexColor(template.SecondaryColor, entity.DefaultCertificateSecondaryColor),
+	}
+}
+
 // MarotoGenerator implementa CertificateGenerator usando maroto v2
 type MarotoGenerator struct{}
 
@@ -47,10 +66,17 @@ func (g *MarotoGenerator) Generate(_ context.Context, data CertificateData) ([]b
 
 	m := maroto.New(cfg)
 
-	m.AddRows(g.buildHeader(data)...)
-	m.AddRows(g.buildContent(data)...)
-	m.AddRows(g.buildSignatures(data)...)
-	m.AddRows(g.buildFooter()...)
+	p := newPalette(data.Template)
+
+	m.AddRows(g.buildHeader(data, p)...)
+	m.AddRows(g.buildContent(data, p)...)
+	m.AddRows(g.buildSignatures(data, p)...)
+	m.AddRows(g.buildFooter(data, p)...)
+
+	// Verso com as atividades do certificado consolidado
+	if len(data.Activities) > 0 {
+		m.AddPages(page.New().Add(g.buildActivities(data, p)...))
+	}
 
 	doc, err := m.Generate()
 	if err != nil {
@@ -60,16 +86,29 @@ func (g *MarotoGenerator) Generate(_ context.Context, data CertificateData) ([]b
 	return doc.GetBytes(), nil
 }
 
-func (g *MarotoGenerator) buildHeader(_ CertificateData) []core.Row {
-	return []core.Row{
+func (g *MarotoGenerator) buildHeader(data CertificateData, p palette) []core.Row {
+	rows := []core.Row{}
+
+	if logo := data.Template.Logo; logo != nil {
+		// Logo no lugar do espaço superior
+		rows = append(rows,
+			image.NewFromBytesRow(14, logo.Content, extension.Type(logo.Extension), props.Rect{
+				Center:  true,
+				Percent: 100,
+			}),
+			row.New(4),
+		)
+	} else {
 		// Espaço superior
-		row.New(10),
+		rows = append(rows, row.New(10))
+	}
 
+	return append(rows,
 		// Linha decorativa superior
 		row.New(2).Add(
 			col.New(2),
 			line.NewCol(8, props.Line{
-				Color:     goldColor,
+				Color:     p.accent,
 				Thickness: 1,
 			}),
 			col.New(2),
@@ -78,14 +117,14 @@ func (g *MarotoGenerator) buildHeader(_ CertificateData) []core.Row {
 		// Espaço
 		row.New(8),
 
-		// Título "Certificado"
+		// Título
 		row.New(20).Add(
 			col.New(12).Add(
-				text.New("CERTIFICADO", props.Text{
+				text.New(data.Template.Title, props.Text{
 					Size:  36,
 					Style: fontstyle.Bold,
 					Align: align.Center,
-					Color: primaryColor,
+					Color: p.primary,
 				}),
 			),
 		),
@@ -94,7 +133,7 @@ func (g *MarotoGenerator) buildHeader(_ CertificateData) []core.Row {
 		row.New(2).Add(
 			col.New(3),
 			line.NewCol(6, props.Line{
-				Color:     goldColor,
+				Color:     p.accent,
 				Thickness: 0.5,
 			}),
 			col.New(3),
@@ -102,78 +141,70 @@ func (g *MarotoGenerator) buildHeader(_ CertificateData) []core.Row {
 
 		// Espaço
 		row.New(8),
-	}
+	)
 }
 
-func (g *MarotoGenerator) buildContent(data CertificateData) []core.Row {
-	return []core.Row{
-		// "Certificamos que"
-		row.New(8).Add(
-			col.New(12).Add(
-				text.New("Certificamos que", props.Text{
-					Size:  12,
-					Align: align.Center,
-					Color: grayColor,
-				}),
-			),
-		),
-
-		// Espaço
-		row.New(5),
-
-		// Nome do participante
-		row.New(15).Add(
-			col.New(12).Add(
-				text.New(data.RecipientName, props.Text{
-					Size:  24,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Color: primaryColor,
-				}),
-			),
-		),
-
-		// Espaço
-		row.New(5),
-
-		// Descrição
-		row.New(8).Add(
-			col.New(1),
-			col.New(10).Add(
-				text.New("participou do evento", props.Text{
-					Size:  11,
-					Align: align.Center,
-					Color: grayColor,
-				}),
+func (g *MarotoGenerator) buildContent(data CertificateData, p palette) []core.Row {
+	// O nome do participante é destacado; o texto antes e depois dele vira parágrafo
+	before, after, hasName := strings.Cut(g.renderBodyText(data), entity.PlaceholderParticipantName)
+
+	rows := []core.Row{}
+
+	if hasName {
+		if before = strings.TrimSpace(before); before != "" {
+			rows = append(rows,
+				// Texto antes do nome (ex: "Certificamos que")
+				row.New(8).Add(
+					col.New(12).Add(
+						text.New(before, props.Text{
+							Size:  12,
+							Align: align.Center,
+							Color: grayColor,
+						}),
+					),
+				),
+
+				// Espaço
+				row.New(5),
+			)
+		}
+
+		rows = append(rows,
+			// Nome do participante
+			row.New(15).Add(
+				col.New(12).Add(
+					text.New(data.RecipientName, props.Text{
+						Size:  24,
+						Style: fontstyle.Bold,
+						Align: align.Center,
+						Color: p.primary,
+					}),
+				),
 			),
-			col.New(1),
-		),
 
-		// Nome do evento
-		row.New(10).Add(
-			col.New(12).Add(
-				text.New(data.EventName, props.Text{
-					Size:  14,
-					Style: fontstyle.Bold,
-					Align: align.Center,
-					Color: darkBlueColor,
-				}),
-			),
-		),
+			// Espaço
+			row.New(5),
+		)
+	}
 
-		// Data e carga horária
-		row.New(8).Add(
-			col.New(1),
-			col.New(10).Add(
-				text.New("realizado em "+data.EventDate+", com carga horária total de "+data.Workload+".", props.Text{
-					Size:  11,
-					Align: align.Center,
-					Color: grayColor,
-				}),
+	if after = strings.TrimSpace(after); after != "" {
+		// Descrição com o nome do evento, data e carga horária
+		rows = append(rows,
+			row.New().Add(
+				col.New(1),
+				col.New(10).Add(
+					text.New(after, props.Text{
+						Size:  12,
+						Align: align.Center,
+						Color: p.secondary,
+					}),
+				),
+				col.New(1),
 			),
-			col.New(1),
-		),
+		)
+	}
 
+	return append(rows,
 		// Espaço
 		row.New(10),
 
@@ -209,7 +240,7 @@ func (g *MarotoGenerator) buildContent(data CertificateData) []core.Row {
 					Size:  10,
 					Style: fontstyle.Bold,
 					Align: align.Center,
-					Color: primaryColor,
+					Color: p.primary,
 				}),
 			),
 			col.New(4).Add(
@@ -217,7 +248,7 @@ func (g *MarotoGenerator) buildContent(data CertificateData) []core.Row {
 					Size:  10,
 					Style: fontstyle.Bold,
 					Align: align.Center,
-					Color: primaryColor,
+					Color: p.primary,
 				}),
 			),
 			col.New(4).Add(
@@ -225,96 +256,311 @@ func (g *MarotoGenerator) buildContent(data CertificateData) []core.Row {
 					Size:  10,
 					Style: fontstyle.Bold,
 					Align: align.Center,
-					Color: primaryColor,
+					Color: p.primary,
 				}),
 			),
 		),
+	)
+}
+
+func (g *MarotoGenerator) buildSignatures(data CertificateData, p palette) []core.Row {
+	signatories := data.Template.Signatories
+	if len(signatories) == 0 {
+		return []core.Row{
+			// Espaço no lugar das assinaturas
+			row.New(10),
+		}
+	}
+
+	hasSignatureImage := false
+	for _, s := range signatories {
+		if s.Signature != nil {
+			hasSignatureImage = true
+		}
+	}
 
+	rows := []core.Row{}
+
+	if hasSignatureImage {
+		rows = append(rows,
+			// Espaço
+			row.New(2),
+
+			// Imagens das assinaturas, acima das linhas
+			row.New(12).Add(signatoryCols(signatories, func(s Signatory, size int) core.Col {
+				if s.Signature == nil {
+					return col.New(size)
+				}
+				return image.NewFromBytesCol(size, s.Signature.Content, extension.Type(s.Signature.Extension), props.Rect{
+					Center:  true,
+					Percent: 100,
+				})
+			})...),
+		)
+	} else {
 		// Espaço antes das assinaturas
-		row.New(10),
+		rows = append(rows, row.New(10))
 	}
-}
 
-func (g *MarotoGenerator) buildSignatures(data CertificateData) []core.Row {
-	return []core.Row{
+	return append(rows,
 		// Linhas de assinatura
-		row.New(1).Add(
-			col.New(2),
-			line.NewCol(3, props.Line{
-				Color:     primaryColor,
+		row.New(1).Add(signatoryCols(signatories, func(_ Signatory, size int) core.Col {
+			return line.NewCol(size, props.Line{
+				Color:     p.primary,
 				Thickness: 0.5,
-			}),
-			col.New(2),
-			line.NewCol(3, props.Line{
-				Color:     primaryColor,
-				Thickness: 0.5,
-			}),
-			col.New(2),
-		),
+			})
+		})...),
 
 		// Espaço
 		row.New(2),
 
 		// Nomes dos signatários
-		row.New(6).Add(
-			col.New(2),
-			col.New(3).Add(
-				text.New(data.DirectorName, props.Text{
+		row.New(6).Add(signatoryCols(signatories, func(s Signatory, size int) core.Col {
+			return col.New(size).Add(
+				text.New(s.Name, props.Text{
 					Size:  9,
 					Style: fontstyle.Bold,
 					Align: align.Center,
-					Color: primaryColor,
+					Color: p.primary,
 				}),
+			)
+		})...),
+
+		// Títulos
+		row.New(5).Add(signatoryCols(signatories, func(s Signatory, size int) core.Col {
+			return col.New(size).Add(
+				text.New(s.Title, props.Text{
+					Size:  8,
+					Align: align.Center,
+					Color: grayColor,
+				}),
+			)
+		})...),
+	)
+}
+
+func (g *MarotoGenerator) buildFooter(data CertificateData, p palette) []core.Row {
+	if data.VerificationCode == "" {
+		return []core.Row{
+			// Espaço
+			row.New(8),
+
+			// Rodapé
+			row.New(5).Add(
+				col.New(12).Add(
+					text.New("Certificado gerado automaticamente pelo sistema Checkin Gate", props.Text{
+						Size:  8,
+						Align: align.Center,
+						Color: lightGrayColor,
+					}),
+				),
 			),
+		}
+	}
+
+	return []core.Row{
+		// Espaço
+		row.New(4),
+
+		// Rodapé com código de verificação e QR code apontando para a URL pública
+		row.New(18).Add(
 			col.New(2),
-			col.New(3).Add(
-				text.New(data.CoordinatorName, props.Text{
+			col.New(8).Add(
+				text.New("Código de verificação: "+data.VerificationCode, props.Text{
 					Size:  9,
 					Style: fontstyle.Bold,
 					Align: align.Center,
-					Color: primaryColor,
+					Color: p.primary,
+					Top:   2,
 				}),
-			),
-			col.New(2),
-		),
-
-		// Títulos
-		row.New(5).Add(
-			col.New(2),
-			col.New(3).Add(
-				text.New("Diretor(a)", props.Text{
+				text.New("Verifique a autenticidade em "+data.VerificationURL, props.Text{
 					Size:  8,
 					Align: align.Center,
 					Color: grayColor,
+					Top:   7,
 				}),
-			),
-			col.New(2),
-			col.New(3).Add(
-				text.New("Coordenador(a)", props.Text{
+				text.New("Certificado gerado automaticamente pelo sistema Checkin Gate", props.Text{
 					Size:  8,
 					Align: align.Center,
-					Color: grayColor,
+					Color: lightGrayColor,
+					Top:   12,
 				}),
 			),
-			col.New(2),
+			code.NewQrCol(2, data.VerificationURL, props.Rect{
+				Center:  true,
+				Percent: 100,
+			}),
 		),
 	}
 }
 
-func (g *MarotoGenerator) buildFooter() []core.Row {
-	return []core.Row{
-		// Espaço
-		row.New(8),
+func (g *MarotoGenerator) buildActivities(data CertificateData, p palette) []core.Row {
+	rows := []core.Row{
+		// Espaço superior
+		row.New(10),
 
-		// Rodapé
-		row.New(5).Add(
+		// Título
+		row.New(12).Add(
 			col.New(12).Add(
-				text.New("Certificado gerado automaticamente pelo sistema Checkin Gate", props.Text{
+				text.New("ATIVIDADES REALIZADAS", props.Text{
+					Size:  18,
+					Style: fontstyle.Bold,
+					Align: align.Center,
+					Color: p.primary,
+				}),
+			),
+		),
+
+		// Participante e evento
+		row.New(8).Add(
+			col.New(12).Add(
+				text.New(data.RecipientName+" - "+data.EventName, props.Text{
+					Size:  10,
+					Align: align.Center,
+					Color: grayColor,
+				}),
+			),
+		),
+
+		// Linha decorativa
+		row.New(4).Add(
+			col.New(2),
+			line.NewCol(8, props.Line{
+				Color:     p.accent,
+				Thickness: 0.5,
+			}),
+			col.New(2),
+		),
+
+		// Cabeçalho da tabela
+		row.New(8).Add(
+			col.New(1),
+			col.New(6).Add(
+				text.New("ATIVIDADE", props.Text{
+					Size:  8,
+					Style: fontstyle.Bold,
+					Color: lightGrayColor,
+				}),
+			),
+			col.New(2).Add(
+				text.New("DATA", props.Text{
+					Size:  8,
+					Style: fontstyle.Bold,
+					Align: align.Center,
+					Color: lightGrayColor,
+				}),
+			),
+			col.New(2).Add(
+				text.New("CARGA HORÁRIA", props.Text{
 					Size:  8,
+					Style: fontstyle.Bold,
 					Align: align.Center,
 					Color: lightGrayColor,
 				}),
 			),
+			col.New(1),
+		),
+	}
+
+	// Uma linha por atividade
+	for _, activity := range data.Activities {
+		rows = append(rows,
+			row.New(7).Add(
+				col.New(1),
+				col.New(6).Add(
+					text.New(activity.Name, props.Text{
+						Size:  10,
+						Color: p.primary,
+					}),
+				),
+				col.New(2).Add(
+					text.New(activity.Date, props.Text{
+						Size:  9,
+						Align: align.Center,
+						Color: grayColor,
+					}),
+				),
+				col.New(2).Add(
+					text.New(activity.Workload, props.Text{
+						Size:  9,
+						Align: align.Center,
+						Color: grayColor,
+					}),
+				),
+				col.New(1),
+			),
+		)
+	}
+
+	return append(rows,
+		// Total
+		row.New(4).Add(
+			col.New(1),
+			line.NewCol(10, props.Line{
+				Color:       lightGrayColor,
+				Thickness:   0.2,
+				SizePercent: 100,
+			}),
+			col.New(1),
 		),
+		row.New(8).Add(
+			col.New(7),
+			col.New(2).Add(
+				text.New("TOTAL", props.Text{
+					Size:  9,
+					Style: fontstyle.Bold,
+					Align: align.Center,
+					Color: p.primary,
+				}),
+			),
+			col.New(2).Add(
+				text.New(data.Workload, props.Text{
+					Size:  9,
+					Style: fontstyle.Bold,
+					Align: align.Center,
+					Color: p.primary,
+				}),
+			),
+			col.New(1),
+		),
+	)
+}
+
+// renderBodyText substitui os placeholders do texto, mantendo o do nome do participante
+// para que ele seja renderizado em destaque
+func (g *MarotoGenerator) renderBodyText(data CertificateData) string {
+	return strings.NewReplacer(
+		entity.PlaceholderEventName, data.EventName,
+		entity.PlaceholderActivityName, data.ActivityName,
+		entity.PlaceholderEventDate, data.EventDate,
+		entity.PlaceholderWorkload, data.Workload,
+		entity.PlaceholderIssueDate, data.CertificateDate,
+	).Replace(data.Template.BodyText)
+}
+
+// signatoryCols distribui os signatários centralizados no grid de 12 colunas
+// 1 signatário: 4|4|4, 2 signatários: 2|3|2|3|2, 3 signatários: 4|4|4
+func signatoryCols(signatories []Signatory, build func(s Signatory, size int) core.Col) []core.Col {
+	switch len(signatories) {
+	case 1:
+		return []core.Col{col.New(4), build(signatories[0], 4), col.New(4)}
+	case 2:
+		return []core.Col{col.New(2), build(signatories[0], 3), col.New(2), build(signatories[1], 3), col.New(2)}
+	default:
+		size := 12 / len(signatories)
+		cols := make([]core.Col, len(signatories))
+		for i, s := range signatories {
+			cols[i] = build(s, size)
+		}
+		return cols
+	}
+}
+
+// parseHexColor converte "#rrggbb" para props.Color, usando o fallback se o valor for inválido
+func parseHexColor(hex, fallback string) *props.Color {
+	var r, g, b int
+	if _, err := fmt.Sscanf(hex, "#%02x%02x%02x", &r, &g, &b); err != nil {
+		_, _ = fmt.Sscanf(fallback, "#%02x%02x%02x", &r, &g, &b)
 	}
+	return &props.Color{Red: r, Green: g, Blue: b}
 }
@@ -0,0 +1,87 @@
+package pdf
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/storage"
+)
+
+// TemplateLoader monta o CertificateTemplate de um evento a partir do banco e do blob storage
+type TemplateLoader struct {
+	templateRepo repository.CertificateTemplateRepository
+	blobStorage  storage.BlobStorage
+}
+
+func NewTemplateLoader(templateRepo repository.CertificateTemplateRepository, blobStorage storage.BlobStorage) *TemplateLoader {
+	return &TemplateLoader{
+		templateRepo: templateRepo,
+		blobStorage:  blobStorage,
+	}
+}
+
+// Load retorna o template do evento ou o template padrão se o evento não tiver um
+func (l *TemplateLoader) Load(ctx context.Context, eventID string) (CertificateTemplate, error) {
+	template, err := l.templateRepo.FindByEventID(ctx, eventID)
+	if err != nil {
+		return CertificateTemplate{}, fmt.Errorf("failed to find certificate template: %w", err)
+	}
+
+	if template == nil {
+		template = entity.DefaultCertificateTemplate(eventID)
+	}
+
+	return l.FromEntity(ctx, template)
+}
+
+// FromEntity carrega as imagens do template e converte para o formato do gerador
+func (l *TemplateLoader) FromEntity(ctx context.Context, template *entity.CertificateTemplate) (CertificateTemplate, error) {
+	logo, err := l.loadImage(ctx, template.LogoKey)
+	if err != nil {
+		return CertificateTemplate{}, err
+	}
+
+	signatories := make([]Signatory, len(template.Signatories))
+	for i, s := range template.Signatories {
+		signature, err := l.loadImage(ctx, s.SignatureKey)
+		if err != nil {
+			return CertificateTemplate{}, err
+		}
+
+		signatories[i] = Signatory{
+			Name:      s.Name,
+			Title:     s.Title,
+			Signature: signature,
+		}
+	}
+
+	return CertificateTemplate{
+		Title:          template.Title,
+		BodyText:       template.BodyText,
+		Signatories:    signatories,
+		Logo:           logo,
+		PrimaryColor:   template.PrimaryColor,
+		AccentColor:    template.AccentColor,
+		SecondaryColor: template.SecondaryColor,
+	}, nil
+}
+
+func (l *TemplateLoader) loadImage(ctx context.Context, key *string) (*Image, error) {
+	if key == nil {
+		return nil, nil
+	}
+
+	content, err := l.blobStorage.Get(ctx, *key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate image %s: %w", *key, err)
+	}
+
+	return &Image{
+		Content:   content,
+		Extension: strings.TrimPrefix(path.Ext(*key), "."),
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -14,6 +15,14 @@ import (
 	"github.com/lib/pq"
 )
 
+// activityColumns são as colunas selecionadas/retornadas para um entity.Activity
+var activityColumns = []string{
+	"id", "name", "event_id", "description", "start_date", "end_date", "track", "capacity", "created_at", "updated_at",
+	"checkin_token_required", "checkin_token_period_seconds",
+	"checkin_window_enabled", "checkin_opens_before_minutes", "checkin_closes_after_minutes",
+	"venue_name", "venue_latitude", "venue_longitude", "venue_radius_meters",
+}
+
 type PostgresActivityRepository struct {
 	db shared.DBTX
 }
@@ -30,9 +39,11 @@ func (r *PostgresActivityRepository) WithTx(tx *sqlx.Tx) *PostgresActivityReposi
 func (r *PostgresActivityRepository) Save(ctx context.Context, activity *entity.Activity) (*entity.Activity, error) {
 	query, args, err := psql.
 		Insert("activities").
-		Columns("id", "name", "event_id", "description", "start_date", "end_date").
-		Values(activity.ID, activity.Name, activity.EventID, activity.Description, activity.StartDate, activity.EndDate).
-		Suffix("RETURNING id, name, event_id, description, start_date, end_date, created_at, updated_at").
+		Columns("id", "name", "event_id", "description", "start_date", "end_date", "track", "capacity",
+			"venue_name", "venue_latitude", "venue_longitude", "venue_radius_meters").
+		Values(activity.ID, activity.Name, activity.EventID, activity.Description, activity.StartDate, activity.EndDate, activity.Track, activity.Capacity,
+			activity.VenueName, activity.VenueLatitude, activity.VenueLongitude, activity.VenueRadiusMeters).
+		Suffix("RETURNING " + strings.Join(activityColumns, ", ")).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -53,14 +64,16 @@ func (r *PostgresActivityRepository) SaveAll(ctx context.Context, activities []*
 
 	builder := psql.
 		Insert("activities").
-		Columns("id", "name", "event_id", "description", "start_date", "end_date")
+		Columns("id", "name", "event_id", "description", "start_date", "end_date", "track", "capacity",
+			"venue_name", "venue_latitude", "venue_longitude", "venue_radius_meters")
 
 	for _, a := range activities {
-		builder = builder.Values(a.ID, a.Name, a.EventID, a.Description, a.StartDate, a.EndDate)
+		builder = builder.Values(a.ID, a.Name, a.EventID, a.Description, a.StartDate, a.EndDate, a.Track, a.Capacity,
+			a.VenueName, a.VenueLatitude, a.VenueLongitude, a.VenueRadiusMeters)
 	}
 
 	query, args, err := builder.
-		Suffix("RETURNING id, name, event_id, description, start_date, end_date, created_at, updated_at").
+		Suffix("RETURNING " + strings.Join(activityColumns, ", ")).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -81,7 +94,7 @@ func (r *PostgresActivityRepository) SaveAll(ctx context.Context, activities []*
 
 func (r *PostgresActivityRepository) FindByEventIDAndNames(ctx context.Context, eventID string, names []string) ([]*entity.Activity, error) {
 	query, args, err := psql.
-		Select("id", "name", "event_id", "description", "start_date", "end_date", "created_at", "updated_at").
+		Select(activityColumns...).
 		From("activities").
 		Where(sq.Eq{"event_id": eventID, "name": names}).
 		ToSql()
@@ -104,9 +117,32 @@ func (r *PostgresActivityRepository) FindByEventIDAndNames(ctx context.Context,
 
 func (r *PostgresActivityRepository) FindByID(ctx context.Context, id string) (*entity.Activity, error) {
 	query, args, err := psql.
-		Select("id", "name", "event_id", "description", "start_date", "end_date", "created_at", "updated_at").
+		Select(activityColumns...).
+		From("activities").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.Activity
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+// FindByIDForUpdate busca a atividade bloqueando a linha até o fim da transação
+func (r *PostgresActivityRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.Activity, error) {
+	query, args, err := psql.
+		Select(activityColumns...).
 		From("activities").
 		Where(sq.Eq{"id": id}).
+		Suffix("FOR UPDATE").
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -125,7 +161,7 @@ func (r *PostgresActivityRepository) FindByID(ctx context.Context, id string) (*
 
 func (r *PostgresActivityRepository) FindByEventID(ctx context.Context, eventID string) ([]*entity.Activity, error) {
 	query, args, err := psql.
-		Select("id", "name", "event_id", "description", "start_date", "end_date", "created_at", "updated_at").
+		Select(activityColumns...).
 		From("activities").
 		Where(sq.Eq{"event_id": eventID}).
 		OrderBy("start_date ASC").
@@ -149,7 +185,7 @@ func (r *PostgresActivityRepository) FindByEventID(ctx context.Context, eventID
 
 func (r *PostgresActivityRepository) FindAll(ctx context.Context) ([]*entity.Activity, error) {
 	query, args, err := psql.
-		Select("id", "name", "event_id", "description", "start_date", "end_date", "created_at", "updated_at").
+		Select(activityColumns...).
 		From("activities").
 		OrderBy("start_date ASC").
 		ToSql()
@@ -177,9 +213,44 @@ func (r *PostgresActivityRepository) Update(ctx context.Context, activity *entit
 		Set("description", activity.Description).
 		Set("start_date", activity.StartDate).
 		Set("end_date", activity.EndDate).
+		Set("track", activity.Track).
+		Set("capacity", activity.Capacity).
+		Set("venue_name", activity.VenueName).
+		Set("venue_latitude", activity.VenueLatitude).
+		Set("venue_longitude", activity.VenueLongitude).
+		Set("venue_radius_meters", activity.VenueRadiusMeters).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": activity.ID}).
+		Suffix("RETURNING " + strings.Join(activityColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.Activity
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+// UpdateCheckInSettings grava as configurações de check-in da atividade
+func (r *PostgresActivityRepository) UpdateCheckInSettings(ctx context.Context, activity *entity.Activity) (*entity.Activity, error) {
+	query, args, err := psql.
+		Update("activities").
+		Set("checkin_token_required", activity.CheckInTokenRequired).
+		Set("checkin_token_period_seconds", activity.CheckInTokenPeriodSeconds).
+		Set("checkin_window_enabled", activity.CheckInWindowEnabled).
+		Set("checkin_opens_before_minutes", activity.CheckInOpensBeforeMinutes).
+		Set("checkin_closes_after_minutes", activity.CheckInClosesAfterMinutes).
+		Set("venue_name", activity.VenueName).
+		Set("venue_latitude", activity.VenueLatitude).
+		Set("venue_longitude", activity.VenueLongitude).
+		Set("venue_radius_meters", activity.VenueRadiusMeters).
 		Set("updated_at", sq.Expr("NOW()")).
 		Where(sq.Eq{"id": activity.ID}).
-		Suffix("RETURNING id, name, event_id, description, start_date, end_date, created_at, updated_at").
+		Suffix("RETURNING " + strings.Join(activityColumns, ", ")).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -206,6 +277,19 @@ func (r *PostgresActivityRepository) Delete(ctx context.Context, id string) erro
 	return err
 }
 
+func (r *PostgresActivityRepository) DeleteByEventID(ctx context.Context, eventID string) error {
+	query, args, err := psql.
+		Delete("activities").
+		Where(sq.Eq{"event_id": eventID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
 func (r *PostgresActivityRepository) FindByActivityIDWithEvent(ctx context.Context, activityID string) (*repository.ActivityWithEvent, error) {
 	// Struct para scan do JOIN
 	var row struct {
@@ -216,17 +300,35 @@ func (r *PostgresActivityRepository) FindByActivityIDWithEvent(ctx context.Conte
 		Description *string    `db:"description"`
 		StartDate   time.Time  `db:"start_date"`
 		EndDate     time.Time  `db:"end_date"`
+		Track       *string    `db:"track"`
+		Capacity    *int       `db:"capacity"`
 		CreatedAt   time.Time  `db:"created_at"`
 		UpdatedAt   *time.Time `db:"updated_at"`
+
+		CheckInTokenRequired      bool `db:"checkin_token_required"`
+		CheckInTokenPeriodSeconds int  `db:"checkin_token_period_seconds"`
+		CheckInWindowEnabled      bool `db:"checkin_window_enabled"`
+		CheckInOpensBeforeMinutes int  `db:"checkin_opens_before_minutes"`
+		CheckInClosesAfterMinutes int  `db:"checkin_closes_after_minutes"`
+		entity.Venue
 		// Event fields
-		EventName           string         `db:"event_name"`
-		EventStatus         string         `db:"event_status"`
-		EventAllowedDomains pq.StringArray `db:"event_allowed_domains"`
-		EventDescription    *string        `db:"event_description"`
-		EventStartDate      time.Time      `db:"event_start_date"`
-		EventEndDate        time.Time      `db:"event_end_date"`
-		EventCreatedAt      time.Time      `db:"event_created_at"`
-		EventUpdatedAt      *time.Time     `db:"event_updated_at"`
+		EventName                 string         `db:"event_name"`
+		EventStatus               string         `db:"event_status"`
+		EventCertificateMode      string         `db:"event_certificate_mode"`
+		EventAttendanceRule       string         `db:"event_attendance_rule"`
+		EventAttendanceMin        float64        `db:"event_attendance_threshold"`
+		EventWorkloadSource       string         `db:"event_workload_source"`
+		EventRegistrationRequired bool           `db:"event_registration_required"`
+		EventAllowedDomains       pq.StringArray `db:"event_allowed_domains"`
+		EventDescription          *string        `db:"event_description"`
+		EventStartDate            time.Time      `db:"event_start_date"`
+		EventEndDate              time.Time      `db:"event_end_date"`
+		EventCreatedAt            time.Time      `db:"event_created_at"`
+		EventUpdatedAt            *time.Time     `db:"event_updated_at"`
+		EventVenueName            *string        `db:"event_venue_name"`
+		EventVenueLatitude        *float64       `db:"event_venue_latitude"`
+		EventVenueLongitude       *float64       `db:"event_venue_longitude"`
+		EventVenueRadius          *int           `db:"event_venue_radius_meters"`
 	}
 
 	query, args, err := psql.
@@ -237,13 +339,33 @@ func (r *PostgresActivityRepository) FindByActivityIDWithEvent(ctx context.Conte
 			"a.description",
 			"a.start_date",
 			"a.end_date",
+			"a.track",
+			"a.capacity",
 			"a.created_at",
 			"a.updated_at",
+			"a.checkin_token_required",
+			"a.checkin_token_period_seconds",
+			"a.checkin_window_enabled",
+			"a.checkin_opens_before_minutes",
+			"a.checkin_closes_after_minutes",
+			"a.venue_name",
+			"a.venue_latitude",
+			"a.venue_longitude",
+			"a.venue_radius_meters",
 			"e.name AS event_name",
 			"e.allowed_domains AS event_allowed_domains",
 			"e.description AS event_description",
 			"e.status AS event_status",
+			"e.certificate_mode AS event_certificate_mode",
+			"e.attendance_rule AS event_attendance_rule",
+			"e.attendance_threshold AS event_attendance_threshold",
+			"e.workload_source AS event_workload_source",
+			"e.registration_required AS event_registration_required",
 			"e.start_date AS event_start_date", "e.end_date AS event_end_date", "e.created_at AS event_created_at", "e.updated_at AS event_updated_at",
+			"e.venue_name AS event_venue_name",
+			"e.venue_latitude AS event_venue_latitude",
+			"e.venue_longitude AS event_venue_longitude",
+			"e.venue_radius_meters AS event_venue_radius_meters",
 		).
 		From("activities a").
 		InnerJoin("events e ON a.event_id = e.id").
@@ -268,19 +390,39 @@ func (r *PostgresActivityRepository) FindByActivityIDWithEvent(ctx context.Conte
 			Description: row.Description,
 			StartDate:   row.StartDate,
 			EndDate:     row.EndDate,
+			Track:       row.Track,
+			Capacity:    row.Capacity,
 			CreatedAt:   row.CreatedAt,
 			UpdatedAt:   row.UpdatedAt,
+
+			CheckInTokenRequired:      row.CheckInTokenRequired,
+			CheckInTokenPeriodSeconds: row.CheckInTokenPeriodSeconds,
+			CheckInWindowEnabled:      row.CheckInWindowEnabled,
+			CheckInOpensBeforeMinutes: row.CheckInOpensBeforeMinutes,
+			CheckInClosesAfterMinutes: row.CheckInClosesAfterMinutes,
+			Venue:                     row.Venue,
 		},
 		Event: &entity.Event{
-			ID:             row.EventID,
-			Name:           row.EventName,
-			Status:         entity.EventStatus(row.EventStatus),
-			AllowedDomains: row.EventAllowedDomains,
-			Description:    row.EventDescription,
-			StartDate:      row.EventStartDate,
-			EndDate:        row.EventEndDate,
-			CreatedAt:      row.EventCreatedAt,
-			UpdatedAt:      row.EventUpdatedAt,
+			ID:                   row.EventID,
+			Name:                 row.EventName,
+			Status:               entity.EventStatus(row.EventStatus),
+			CertificateMode:      entity.CertificateMode(row.EventCertificateMode),
+			AttendanceRule:       entity.AttendanceRule(row.EventAttendanceRule),
+			AttendanceThreshold:  row.EventAttendanceMin,
+			WorkloadSource:       entity.WorkloadSource(row.EventWorkloadSource),
+			RegistrationRequired: row.EventRegistrationRequired,
+			AllowedDomains:       row.EventAllowedDomains,
+			Description:          row.EventDescription,
+			StartDate:            row.EventStartDate,
+			EndDate:              row.EventEndDate,
+			CreatedAt:            row.EventCreatedAt,
+			UpdatedAt:            row.EventUpdatedAt,
+			Venue: entity.Venue{
+				VenueName:         row.EventVenueName,
+				VenueLatitude:     row.EventVenueLatitude,
+				VenueLongitude:    row.EventVenueLongitude,
+				VenueRadiusMeters: row.EventVenueRadius,
+			},
 		},
 	}, nil
 }
@@ -0,0 +1,331 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared"
+	"github.com/jmoiron/sqlx"
+)
+
+// limita os parâmetros de cada INSERT (o Postgres aceita até 65535 por query)
+const jobInsertBatchSize = 1000
+
+var certificateJobColumns = []string{
+	"job_id", "event_id", "payload", "status", "attempts", "last_error",
+	"created_at", "updated_at", "dispatched_at",
+}
+
+type certificateJobRow struct {
+	JobID        string     `db:"job_id"`
+	EventID      string     `db:"event_id"`
+	Payload      []byte     `db:"payload"`
+	Status       string     `db:"status"`
+	Attempts     int        `db:"attempts"`
+	LastError    *string    `db:"last_error"`
+	CreatedAt    time.Time  `db:"created_at"`
+	UpdatedAt    time.Time  `db:"updated_at"`
+	DispatchedAt *time.Time `db:"dispatched_at"`
+}
+
+func (row *certificateJobRow) toRecord() (*repository.CertificateJobRecord, error) {
+	var job queue.CertificateJob
+	if err := json.Unmarshal(row.Payload, &job); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal certificate job %s: %w", row.JobID, err)
+	}
+
+	return &repository.CertificateJobRecord{
+		Job:          &job,
+		EventID:      row.EventID,
+		Status:       repository.CertificateJobStatus(row.Status),
+		Attempts:     row.Attempts,
+		LastError:    row.LastError,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+		DispatchedAt: row.DispatchedAt,
+	}, nil
+}
+
+type PostgresCertificateJobRepository struct {
+	db shared.DBTX
+}
+
+func NewPostgresCertificateJobRepository(db shared.DBTX) *PostgresCertificateJobRepository {
+	return &PostgresCertificateJobRepository{db: db}
+}
+
+// WithTx retorna uma nova instância do repositório usando a transação fornecida
+func (r *PostgresCertificateJobRepository) WithTx(tx *sqlx.Tx) *PostgresCertificateJobRepository {
+	return &PostgresCertificateJobRepository{db: tx}
+}
+
+func (r *PostgresCertificateJobRepository) Add(ctx context.Context, eventID string, jobs []*queue.CertificateJob) ([]*queue.CertificateJob, error) {
+	added := make([]*queue.CertificateJob, 0, len(jobs))
+
+	for batch := range slices.Chunk(jobs, jobInsertBatchSize) {
+		insert := psql.
+			Insert("certificate_jobs").
+			Columns("job_id", "event_id", "dedup_key", "payload").
+			// o certificado já foi gerado em uma finalização anterior
+			Suffix("ON CONFLICT (dedup_key) DO NOTHING RETURNING job_id")
+
+		for _, job := range batch {
+			payload, err := json.Marshal(job)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal job %s: %w", job.GetJobID(), err)
+			}
+			insert = insert.Values(job.GetJobID(), eventID, job.DedupKey(), payload)
+		}
+
+		query, args, err := insert.ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		var insertedIDs []string
+		if err := r.db.SelectContext(ctx, &insertedIDs, query, args...); err != nil {
+			return nil, err
+		}
+
+		inserted := make(map[string]struct{}, len(insertedIDs))
+		for _, id := range insertedIDs {
+			inserted[id] = struct{}{}
+		}
+		for _, job := range batch {
+			if _, ok := inserted[job.GetJobID()]; ok {
+				added = append(added, job)
+			}
+		}
+	}
+
+	return added, nil
+}
+
+func (r *PostgresCertificateJobRepository) ClaimPending(ctx context.Context, limit int) ([]*queue.CertificateJob, error) {
+	query, args, err := psql.
+		Select("payload").
+		From("certificate_jobs").
+		Where(sq.Eq{"dispatched_at": nil}).
+		OrderBy("created_at ASC", "job_id ASC").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var payloads [][]byte
+	if err := r.db.SelectContext(ctx, &payloads, query, args...); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*queue.CertificateJob, 0, len(payloads))
+	for _, payload := range payloads {
+		var job queue.CertificateJob
+		if err := json.Unmarshal(payload, &job); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal certificate job: %w", err)
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, nil
+}
+
+func (r *PostgresCertificateJobRepository) MarkDispatched(ctx context.Context, jobIDs []string, at time.Time) error {
+	if len(jobIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := psql.
+		Update("certificate_jobs").
+		Set("dispatched_at", at).
+		Where(sq.Eq{"job_id": jobIDs}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *PostgresCertificateJobRepository) FindByID(ctx context.Context, jobID string) (*repository.CertificateJobRecord, error) {
+	query, args, err := psql.
+		Select(certificateJobColumns...).
+		From("certificate_jobs").
+		Where(sq.Eq{"job_id": jobID}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row certificateJobRow
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return row.toRecord()
+}
+
+func (r *PostgresCertificateJobRepository) FindByEventID(ctx context.Context, eventID string, status *repository.CertificateJobStatus) ([]*repository.CertificateJobRecord, error) {
+	builder := psql.
+		Select(certificateJobColumns...).
+		From("certificate_jobs").
+		Where(sq.Eq{"event_id": eventID}).
+		OrderBy("payload->'user_info'->>'user_name' ASC", "created_at ASC", "job_id ASC")
+
+	if status != nil {
+		builder = builder.Where(sq.Eq{"status": string(*status)})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []certificateJobRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	result := make([]*repository.CertificateJobRecord, len(rows))
+	for i := range rows {
+		record, err := rows[i].toRecord()
+		if err != nil {
+			return nil, err
+		}
+		result[i] = record
+	}
+
+	return result, nil
+}
+
+func (r *PostgresCertificateJobRepository) CountByStatus(ctx context.Context, eventID string) (map[repository.CertificateJobStatus]int, error) {
+	query, args, err := psql.
+		Select("status", "COUNT(*) AS total").
+		From("certificate_jobs").
+		Where(sq.Eq{"event_id": eventID}).
+		GroupBy("status").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Status string `db:"status"`
+		Total  int    `db:"total"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[repository.CertificateJobStatus]int, len(rows))
+	for _, row := range rows {
+		counts[repository.CertificateJobStatus(row.Status)] = row.Total
+	}
+
+	return counts, nil
+}
+
+func (r *PostgresCertificateJobRepository) UpdateStatus(ctx context.Context, jobID string, status repository.CertificateJobStatus, lastError *string) error {
+	builder := psql.
+		Update("certificate_jobs").
+		Set("status", string(status)).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"job_id": jobID})
+
+	switch status {
+	case repository.CertificateJobStatusGenerating:
+		builder = builder.Set("attempts", sq.Expr("attempts + 1"))
+	case repository.CertificateJobStatusRetrying, repository.CertificateJobStatusFailed, repository.CertificateJobStatusRevoked:
+		builder = builder.Set("last_error", lastError)
+	case repository.CertificateJobStatusSent:
+		builder = builder.Set("last_error", nil)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+// TransitionStatus verifica o status atual no próprio UPDATE, para não concorrer com o worker ou com um reenvio
+func (r *PostgresCertificateJobRepository) TransitionStatus(ctx context.Context, jobID string, to repository.CertificateJobStatus, from ...repository.CertificateJobStatus) (bool, error) {
+	if len(from) == 0 {
+		return false, nil
+	}
+
+	statuses := make([]string, len(from))
+	for i, status := range from {
+		statuses[i] = string(status)
+	}
+
+	query, args, err := psql.
+		Update("certificate_jobs").
+		Set("status", string(to)).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"job_id": jobID, "status": statuses}).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+// Requeue volta os jobs para queued; dispatched_at nulo faz o relay publicá-los de novo
+// O status é verificado no próprio UPDATE, para não reenviar um job que um worker acabou de pegar
+func (r *PostgresCertificateJobRepository) Requeue(ctx context.Context, jobIDs []string) (int64, error) {
+	if len(jobIDs) == 0 {
+		return 0, nil
+	}
+
+	terminal := repository.CertificateJobTerminalStatuses()
+	statuses := make([]string, len(terminal))
+	for i, status := range terminal {
+		statuses[i] = string(status)
+	}
+
+	query, args, err := psql.
+		Update("certificate_jobs").
+		Set("status", string(repository.CertificateJobStatusQueued)).
+		Set("last_error", nil).
+		Set("dispatched_at", nil).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"job_id": jobIDs, "status": statuses}).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,187 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared"
+	"github.com/jmoiron/sqlx"
+)
+
+var certificateColumns = []string{
+	"id", "job_id", "verification_code", "user_id", "event_id", "activity_id",
+	"recipient_name", "event_name", "activity_name", "workload_minutes",
+	"issued_at", "revoked_at", "revoked_reason", "storage_key", "created_at", "updated_at",
+}
+
+type PostgresCertificateRepository struct {
+	db shared.DBTX
+}
+
+func NewPostgresCertificateRepository(db shared.DBTX) *PostgresCertificateRepository {
+	return &PostgresCertificateRepository{db: db}
+}
+
+// WithTx retorna uma nova instância do repositório usando a transação fornecida
+func (r *PostgresCertificateRepository) WithTx(tx *sqlx.Tx) *PostgresCertificateRepository {
+	return &PostgresCertificateRepository{db: tx}
+}
+
+func (r *PostgresCertificateRepository) Save(ctx context.Context, certificate *entity.Certificate) (*entity.Certificate, error) {
+	query, args, err := psql.
+		Insert("certificates").
+		Columns(
+			"id", "job_id", "verification_code", "user_id", "event_id", "activity_id",
+			"recipient_name", "event_name", "activity_name", "workload_minutes", "issued_at",
+		).
+		Values(
+			certificate.ID, certificate.JobID, certificate.VerificationCode, certificate.UserID, certificate.EventID, certificate.ActivityID,
+			certificate.RecipientName, certificate.EventName, certificate.ActivityName, certificate.WorkloadMinutes, certificate.IssuedAt,
+		).
+		Suffix("ON CONFLICT (job_id) DO NOTHING").
+		Suffix("RETURNING " + strings.Join(certificateColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.Certificate
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		// conflito no job_id: o job já foi processado antes (retry)
+		if errors.Is(err, sql.ErrNoRows) {
+			return r.FindByJobID(ctx, certificate.JobID)
+		}
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func (r *PostgresCertificateRepository) FindByID(ctx context.Context, id string) (*entity.Certificate, error) {
+	return r.findOne(ctx, sq.Eq{"id": id})
+}
+
+func (r *PostgresCertificateRepository) FindByJobID(ctx context.Context, jobID string) (*entity.Certificate, error) {
+	return r.findOne(ctx, sq.Eq{"job_id": jobID})
+}
+
+func (r *PostgresCertificateRepository) FindByVerificationCode(ctx context.Context, code string) (*entity.Certificate, error) {
+	return r.findOne(ctx, sq.Eq{"verification_code": code})
+}
+
+func (r *PostgresCertificateRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.Certificate, error) {
+	query, args, err := psql.
+		Select(certificateColumns...).
+		From("certificates").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("issued_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates []*entity.Certificate
+	if err := r.db.SelectContext(ctx, &certificates, query, args...); err != nil {
+		return nil, err
+	}
+
+	return certificates, nil
+}
+
+func (r *PostgresCertificateRepository) ExistsByEventID(ctx context.Context, eventID string) (bool, error) {
+	return r.exists(ctx, sq.Eq{"event_id": eventID})
+}
+
+func (r *PostgresCertificateRepository) ExistsByActivityID(ctx context.Context, activityID string) (bool, error) {
+	return r.exists(ctx, sq.Eq{"activity_id": activityID})
+}
+
+func (r *PostgresCertificateRepository) exists(ctx context.Context, where sq.Sqlizer) (bool, error) {
+	query, args, err := psql.
+		Select("1").
+		From("certificates").
+		Where(where).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, query, args...); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (r *PostgresCertificateRepository) SetStorageKey(ctx context.Context, id string, storageKey string) error {
+	query, args, err := psql.
+		Update("certificates").
+		Set("storage_key", storageKey).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *PostgresCertificateRepository) RevokeByUserAndActivity(ctx context.Context, userID, activityID string, revokedAt time.Time, reason string) (int64, error) {
+	return r.revoke(ctx, sq.Eq{"user_id": userID, "activity_id": activityID}, revokedAt, reason)
+}
+
+func (r *PostgresCertificateRepository) RevokeConsolidated(ctx context.Context, userID, eventID string, revokedAt time.Time, reason string) (int64, error) {
+	// o consolidado é o certificado do evento sem atividade
+	return r.revoke(ctx, sq.Eq{"user_id": userID, "event_id": eventID, "activity_id": nil}, revokedAt, reason)
+}
+
+// revoke revoga os certificados ainda válidos que atendem a where
+func (r *PostgresCertificateRepository) revoke(ctx context.Context, where sq.Eq, revokedAt time.Time, reason string) (int64, error) {
+	query, args, err := psql.
+		Update("certificates").
+		Set("revoked_at", revokedAt).
+		Set("revoked_reason", reason).
+		Where(where).
+		Where(sq.Eq{"revoked_at": nil}).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (r *PostgresCertificateRepository) findOne(ctx context.Context, where sq.Sqlizer) (*entity.Certificate, error) {
+	query, args, err := psql.
+		Select(certificateColumns...).
+		From("certificates").
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.Certificate
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &row, nil
+}
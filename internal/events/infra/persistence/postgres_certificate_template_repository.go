@@ -0,0 +1,92 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared"
+)
+
+var certificateTemplateColumns = []string{
+	"id", "event_id", "title", "body_text", "signatories", "logo_key",
+	"primary_color", "accent_color", "secondary_color", "created_at", "updated_at",
+}
+
+type PostgresCertificateTemplateRepository struct {
+	db shared.DBTX
+}
+
+func NewPostgresCertificateTemplateRepository(db shared.DBTX) *PostgresCertificateTemplateRepository {
+	return &PostgresCertificateTemplateRepository{db: db}
+}
+
+func (r *PostgresCertificateTemplateRepository) FindByEventID(ctx context.Context, eventID string) (*entity.CertificateTemplate, error) {
+	query, args, err := psql.
+		Select(certificateTemplateColumns...).
+		From("certificate_templates").
+		Where(sq.Eq{"event_id": eventID}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.CertificateTemplate
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func (r *PostgresCertificateTemplateRepository) Upsert(ctx context.Context, template *entity.CertificateTemplate) (*entity.CertificateTemplate, error) {
+	query, args, err := psql.
+		Insert("certificate_templates").
+		Columns(
+			"id", "event_id", "title", "body_text", "signatories", "logo_key",
+			"primary_color", "accent_color", "secondary_color",
+		).
+		Values(
+			template.ID, template.EventID, template.Title, template.BodyText, template.Signatories, template.LogoKey,
+			template.PrimaryColor, template.AccentColor, template.SecondaryColor,
+		).
+		Suffix(`ON CONFLICT (event_id) DO UPDATE SET
+			title = EXCLUDED.title,
+			body_text = EXCLUDED.body_text,
+			signatories = EXCLUDED.signatories,
+			logo_key = EXCLUDED.logo_key,
+			primary_color = EXCLUDED.primary_color,
+			accent_color = EXCLUDED.accent_color,
+			secondary_color = EXCLUDED.secondary_color`).
+		Suffix("RETURNING " + strings.Join(certificateTemplateColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.CertificateTemplate
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func (r *PostgresCertificateTemplateRepository) DeleteByEventID(ctx context.Context, eventID string) error {
+	query, args, err := psql.
+		Delete("certificate_templates").
+		Where(sq.Eq{"event_id": eventID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,77 @@
+package persistence
+
+import (
+	"context"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared"
+	"github.com/jmoiron/sqlx"
+)
+
+// checkInRejectionColumns são as colunas selecionadas/retornadas para um entity.CheckInRejection
+var checkInRejectionColumns = []string{
+	"id", "user_id", "activity_id", "reason", "latitude", "longitude", "distance_meters", "created_at",
+}
+
+type PostgresCheckInRejectionRepository struct {
+	db shared.DBTX
+}
+
+func NewPostgresCheckInRejectionRepository(db shared.DBTX) *PostgresCheckInRejectionRepository {
+	return &PostgresCheckInRejectionRepository{db: db}
+}
+
+// WithTx retorna uma nova instância do repositório usando a transação fornecida
+func (r *PostgresCheckInRejectionRepository) WithTx(tx *sqlx.Tx) *PostgresCheckInRejectionRepository {
+	return &PostgresCheckInRejectionRepository{db: tx}
+}
+
+func (r *PostgresCheckInRejectionRepository) Save(ctx context.Context, rejection *entity.CheckInRejection) (*entity.CheckInRejection, error) {
+	query, args, err := psql.
+		Insert("check_in_rejections").
+		Columns(checkInRejectionColumns...).
+		Values(rejection.ID, rejection.UserID, rejection.ActivityID, rejection.Reason, rejection.Latitude, rejection.Longitude, rejection.DistanceMeters, rejection.CreatedAt).
+		Suffix("RETURNING " + strings.Join(checkInRejectionColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.CheckInRejection
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func (r *PostgresCheckInRejectionRepository) FindByEventID(ctx context.Context, eventID string) ([]*entity.CheckInRejection, error) {
+	columns := make([]string, len(checkInRejectionColumns))
+	for i, column := range checkInRejectionColumns {
+		columns[i] = "r." + column
+	}
+
+	query, args, err := psql.
+		Select(columns...).
+		From("check_in_rejections r").
+		InnerJoin("activities a ON a.id = r.activity_id").
+		Where(sq.Eq{"a.event_id": eventID}).
+		OrderBy("r.created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []entity.CheckInRejection
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.CheckInRejection, len(rows))
+	for i := range rows {
+		result[i] = &rows[i]
+	}
+	return result, nil
+}
@@ -4,13 +4,25 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
 	"github.com/gabrielmatsan/checkin-gate/internal/shared"
 	"github.com/jmoiron/sqlx"
 )
 
+// checkInColumns são as colunas selecionadas/retornadas para um entity.CheckIn
+var checkInColumns = []string{
+	"id", "user_id", "activity_id", "checked_at", "checked_out_at", "attended_minutes",
+	"registered_by", "registration_reason", "revoked_at", "revoked_by", "revocation_reason",
+}
+
+// notRevoked filtra os check-ins ativos
+var notRevoked = sq.Eq{"revoked_at": nil}
+
 type PostgresCheckInRepository struct {
 	db shared.DBTX
 }
@@ -27,9 +39,9 @@ func (r *PostgresCheckInRepository) WithTx(tx *sqlx.Tx) *PostgresCheckInReposito
 func (r *PostgresCheckInRepository) Save(ctx context.Context, checkIn *entity.CheckIn) (*entity.CheckIn, error) {
 	query, args, err := psql.
 		Insert("check_ins").
-		Columns("id", "user_id", "activity_id", "checked_at").
-		Values(checkIn.ID, checkIn.UserID, checkIn.ActivityID, checkIn.CheckedAt).
-		Suffix("RETURNING id, user_id, activity_id, checked_at").
+		Columns("id", "user_id", "activity_id", "checked_at", "registered_by", "registration_reason").
+		Values(checkIn.ID, checkIn.UserID, checkIn.ActivityID, checkIn.CheckedAt, checkIn.RegisteredBy, checkIn.RegistrationReason).
+		Suffix("RETURNING " + strings.Join(checkInColumns, ", ")).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -37,6 +49,10 @@ func (r *PostgresCheckInRepository) Save(ctx context.Context, checkIn *entity.Ch
 
 	var row entity.CheckIn
 	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		// dois check-ins simultâneos passam pela verificação do use case, o índice único barra o segundo
+		if shared.IsUniqueViolation(err) {
+			return nil, entity.ErrAlreadyCheckedIn
+		}
 		return nil, err
 	}
 
@@ -45,9 +61,10 @@ func (r *PostgresCheckInRepository) Save(ctx context.Context, checkIn *entity.Ch
 
 func (r *PostgresCheckInRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.CheckIn, error) {
 	query, args, err := psql.
-		Select("id", "user_id", "activity_id", "checked_at").
+		Select(checkInColumns...).
 		From("check_ins").
 		Where(sq.Eq{"user_id": userID}).
+		Where(notRevoked).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -65,11 +82,73 @@ func (r *PostgresCheckInRepository) FindByUserID(ctx context.Context, userID str
 	return result, nil
 }
 
+func (r *PostgresCheckInRepository) FindPageByUserID(ctx context.Context, userID string, filter repository.UserCheckInListFilter) ([]*repository.UserCheckIn, error) {
+	columns := make([]string, 0, len(checkInColumns)+8)
+	for _, col := range checkInColumns {
+		columns = append(columns, "c."+col)
+	}
+	columns = append(columns,
+		"a.name AS activity_name",
+		"a.start_date AS activity_start_date",
+		"a.end_date AS activity_end_date",
+		"e.id AS event_id",
+		"e.name AS event_name",
+		"e.status AS event_status",
+		"cert.id AS certificate_id",
+		"cert.revoked_at AS certificate_revoked_at",
+	)
+
+	builder := psql.
+		Select(columns...).
+		From("check_ins c").
+		Join("activities a ON a.id = c.activity_id").
+		Join("events e ON e.id = a.event_id").
+		// prefere o certificado da atividade ao consolidado do evento
+		JoinClause(`LEFT JOIN LATERAL (
+			SELECT id, revoked_at
+			FROM certificates
+			WHERE user_id = c.user_id
+				AND event_id = e.id
+				AND (activity_id = c.activity_id OR activity_id IS NULL)
+			ORDER BY activity_id IS NULL, issued_at DESC
+			LIMIT 1
+		) cert ON TRUE`).
+		Where(sq.Eq{"c.user_id": userID}).
+		Where(sq.Eq{"c.revoked_at": nil}).
+		OrderBy("c.checked_at DESC", "c.id DESC").
+		Limit(uint64(filter.Limit))
+
+	if filter.EventID != nil {
+		builder = builder.Where(sq.Eq{"e.id": *filter.EventID})
+	}
+	if filter.After != nil {
+		builder = builder.Where(sq.Expr("(c.checked_at, c.id) < (?, ?)", filter.After.CheckedAt, filter.After.ID))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []repository.UserCheckIn
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	result := make([]*repository.UserCheckIn, len(rows))
+	for i := range rows {
+		result[i] = &rows[i]
+	}
+
+	return result, nil
+}
+
 func (r *PostgresCheckInRepository) FindByActivityID(ctx context.Context, activityID string) ([]*entity.CheckIn, error) {
 	query, args, err := psql.
-		Select("id", "user_id", "activity_id", "checked_at").
+		Select(checkInColumns...).
 		From("check_ins").
 		Where(sq.Eq{"activity_id": activityID}).
+		Where(notRevoked).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -89,7 +168,7 @@ func (r *PostgresCheckInRepository) FindByActivityID(ctx context.Context, activi
 
 func (r *PostgresCheckInRepository) FindByID(ctx context.Context, id string) (*entity.CheckIn, error) {
 	query, args, err := psql.
-		Select("id", "user_id", "activity_id", "checked_at").
+		Select(checkInColumns...).
 		From("check_ins").
 		Where(sq.Eq{"id": id}).
 		ToSql()
@@ -110,9 +189,10 @@ func (r *PostgresCheckInRepository) FindByID(ctx context.Context, id string) (*e
 
 func (r *PostgresCheckInRepository) FindByUserAndActivity(ctx context.Context, userID, activityID string) (*entity.CheckIn, error) {
 	query, args, err := psql.
-		Select("id", "user_id", "activity_id", "checked_at").
+		Select(checkInColumns...).
 		From("check_ins").
 		Where(sq.Eq{"user_id": userID, "activity_id": activityID}).
+		Where(notRevoked).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -131,9 +211,10 @@ func (r *PostgresCheckInRepository) FindByUserAndActivity(ctx context.Context, u
 
 func (r *PostgresCheckInRepository) FindByActivityIDs(ctx context.Context, activityIDs []string) ([]*entity.CheckIn, error) {
 	query, args, err := psql.
-		Select("id", "user_id", "activity_id", "checked_at").
+		Select(checkInColumns...).
 		From("check_ins").
 		Where(sq.Eq{"activity_id": activityIDs}).
+		Where(notRevoked).
 		ToSql()
 
 	if err != nil {
@@ -151,3 +232,63 @@ func (r *PostgresCheckInRepository) FindByActivityIDs(ctx context.Context, activ
 	}
 	return result, nil
 }
+
+// CheckOut grava o horário de saída e o tempo de permanência do check-in
+func (r *PostgresCheckInRepository) CheckOut(ctx context.Context, checkIn *entity.CheckIn) (*entity.CheckIn, error) {
+	query, args, err := psql.
+		Update("check_ins").
+		Set("checked_out_at", checkIn.CheckedOutAt).
+		Set("attended_minutes", checkIn.AttendedMinutes).
+		Where(sq.Eq{"id": checkIn.ID}).
+		Suffix("RETURNING " + strings.Join(checkInColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.CheckIn
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+// Revoke grava a revogação do check-in, mantendo o registro para auditoria
+func (r *PostgresCheckInRepository) Revoke(ctx context.Context, checkIn *entity.CheckIn) (*entity.CheckIn, error) {
+	query, args, err := psql.
+		Update("check_ins").
+		Set("revoked_at", checkIn.RevokedAt).
+		Set("revoked_by", checkIn.RevokedBy).
+		Set("revocation_reason", checkIn.RevocationReason).
+		Where(sq.Eq{"id": checkIn.ID}).
+		Where(notRevoked).
+		Suffix("RETURNING " + strings.Join(checkInColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.CheckIn
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("check-in already revoked")
+		}
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func (r *PostgresCheckInRepository) DeleteByActivityIDs(ctx context.Context, activityIDs []string) error {
+	query, args, err := psql.
+		Delete("check_ins").
+		Where(sq.Eq{"activity_id": activityIDs}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
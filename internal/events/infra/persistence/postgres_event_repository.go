@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
@@ -15,6 +16,14 @@ import (
 
 var psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
+var eventColumns = []string{
+	"id", "name", "allowed_domains", "description", "start_date", "end_date",
+	"status", "certificate_mode", "attendance_rule", "attendance_threshold", "workload_source",
+	"registration_required",
+	"venue_name", "venue_latitude", "venue_longitude", "venue_radius_meters",
+	"created_at", "updated_at",
+}
+
 type PostgresEventRepository struct {
 	db shared.DBTX
 }
@@ -26,9 +35,11 @@ func NewPostgresEventRepository(db shared.DBTX) *PostgresEventRepository {
 func (r *PostgresEventRepository) Save(ctx context.Context, event *entity.Event) (*entity.Event, error) {
 	query, args, err := psql.
 		Insert("events").
-		Columns("id", "name", "allowed_domains", "description", "start_date", "end_date").
-		Values(event.ID, event.Name, pq.StringArray(event.AllowedDomains), event.Description, event.StartDate, event.EndDate).
-		Suffix("RETURNING id, name, allowed_domains, description, start_date, end_date, created_at, updated_at").
+		Columns("id", "name", "allowed_domains", "description", "start_date", "end_date", "status", "certificate_mode",
+			"venue_name", "venue_latitude", "venue_longitude", "venue_radius_meters").
+		Values(event.ID, event.Name, pq.StringArray(event.AllowedDomains), event.Description, event.StartDate, event.EndDate, event.Status, event.CertificateMode,
+			event.VenueName, event.VenueLatitude, event.VenueLongitude, event.VenueRadiusMeters).
+		Suffix("RETURNING " + strings.Join(eventColumns, ", ")).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -44,7 +55,7 @@ func (r *PostgresEventRepository) Save(ctx context.Context, event *entity.Event)
 
 func (r *PostgresEventRepository) FindByID(ctx context.Context, id string) (*entity.Event, error) {
 	query, args, err := psql.
-		Select("id", "name", "allowed_domains", "description", "start_date", "end_date", "created_at", "updated_at").
+		Select(eventColumns...).
 		From("events").
 		Where(sq.Eq{"id": id}).
 		ToSql()
@@ -65,7 +76,7 @@ func (r *PostgresEventRepository) FindByID(ctx context.Context, id string) (*ent
 
 func (r *PostgresEventRepository) FindAll(ctx context.Context) ([]*entity.Event, error) {
 	query, args, err := psql.
-		Select("id", "name", "allowed_domains", "description", "start_date", "end_date", "created_at", "updated_at").
+		Select(eventColumns...).
 		From("events").
 		OrderBy("created_at DESC").
 		ToSql()
@@ -86,6 +97,60 @@ func (r *PostgresEventRepository) FindAll(ctx context.Context) ([]*entity.Event,
 	return result, nil
 }
 
+func (r *PostgresEventRepository) FindPage(ctx context.Context, filter repository.EventListFilter) ([]*entity.Event, error) {
+	builder := psql.
+		Select(eventColumns...).
+		From("events").
+		OrderBy("created_at DESC", "id DESC").
+		Limit(uint64(filter.Limit))
+
+	if len(filter.Statuses) > 0 {
+		builder = builder.Where(sq.Eq{"status": filter.Statuses})
+	}
+	if filter.From != nil {
+		builder = builder.Where(sq.GtOrEq{"end_date": *filter.From})
+	}
+	if filter.To != nil {
+		builder = builder.Where(sq.LtOrEq{"start_date": *filter.To})
+	}
+	if filter.Search != "" {
+		builder = builder.Where(sq.ILike{"name": "%" + escapeLike(filter.Search) + "%"})
+	}
+	if filter.EmailDomain != nil {
+		// sem domínios configurados, o evento é aberto a todos
+		builder = builder.Where(sq.Or{
+			sq.Eq{"allowed_domains": nil},
+			sq.Expr("cardinality(allowed_domains) = 0"),
+			sq.Expr("? = ANY(allowed_domains)", *filter.EmailDomain),
+		})
+	}
+	if filter.After != nil {
+		builder = builder.Where(sq.Expr("(created_at, id) < (?, ?)", filter.After.CreatedAt, filter.After.ID))
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []entity.Event
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Event, len(rows))
+	for i := range rows {
+		result[i] = &rows[i]
+	}
+
+	return result, nil
+}
+
+// escapeLike escapa os curingas do LIKE para a busca ser literal
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func (r *PostgresEventRepository) Update(ctx context.Context, event *entity.Event) (*entity.Event, error) {
 	query, args, err := psql.
 		Update("events").
@@ -96,7 +161,7 @@ func (r *PostgresEventRepository) Update(ctx context.Context, event *entity.Even
 		Set("end_date", event.EndDate).
 		Set("updated_at", sq.Expr("NOW()")).
 		Where(sq.Eq{"id": event.ID}).
-		Suffix("RETURNING id, name, allowed_domains, description, start_date, end_date, created_at, updated_at").
+		Suffix("RETURNING " + strings.Join(eventColumns, ", ")).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -144,9 +209,31 @@ func (r *PostgresEventRepository) PartialUpdate(ctx context.Context, id string,
 	if input.Status != nil {
 		builder = builder.Set("status", *input.Status)
 	}
+	if input.CertificateMode != nil {
+		builder = builder.Set("certificate_mode", *input.CertificateMode)
+	}
+	if input.AttendanceRule != nil {
+		builder = builder.Set("attendance_rule", *input.AttendanceRule)
+	}
+	if input.AttendanceThreshold != nil {
+		builder = builder.Set("attendance_threshold", *input.AttendanceThreshold)
+	}
+	if input.WorkloadSource != nil {
+		builder = builder.Set("workload_source", *input.WorkloadSource)
+	}
+	if input.RegistrationRequired != nil {
+		builder = builder.Set("registration_required", *input.RegistrationRequired)
+	}
+	if input.Venue != nil {
+		builder = builder.
+			Set("venue_name", input.Venue.VenueName).
+			Set("venue_latitude", input.Venue.VenueLatitude).
+			Set("venue_longitude", input.Venue.VenueLongitude).
+			Set("venue_radius_meters", input.Venue.VenueRadiusMeters)
+	}
 
 	builder = builder.Set("updated_at", sq.Expr("NOW()"))
-	builder = builder.Suffix("RETURNING id, name, allowed_domains, description, start_date, end_date, status, created_at, updated_at")
+	builder = builder.Suffix("RETURNING " + strings.Join(eventColumns, ", "))
 
 	query, args, err := builder.ToSql()
 	if err != nil {
@@ -181,11 +268,15 @@ func (r *PostgresEventRepository) FindByIDWithActivitiesAndCheckIns(ctx context.
 									'id', c.id,
 									'user_id', c.user_id,
 									'activity_id', c.activity_id,
-									'checked_at', c.checked_at
+									'checked_at', c.checked_at,
+									'checked_out_at', c.checked_out_at,
+									'attended_minutes', c.attended_minutes,
+									'registered_by', c.registered_by,
+									'registration_reason', c.registration_reason
 								)
 							), '[]'::json)
 							FROM check_ins c
-							WHERE c.activity_id = a.id
+							WHERE c.activity_id = a.id AND c.revoked_at IS NULL
 						)
 					)
 				) FILTER (WHERE a.id IS NOT NULL),
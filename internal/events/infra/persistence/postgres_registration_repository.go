@@ -0,0 +1,263 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared"
+	"github.com/jmoiron/sqlx"
+)
+
+// registrationColumns são as colunas selecionadas/retornadas para um entity.Registration
+var registrationColumns = []string{
+	"id", "user_id", "event_id", "activity_id", "status", "created_at", "cancelled_at", "confirmation_deadline",
+}
+
+// activeRegistration filtra as inscrições nem canceladas nem expiradas
+var activeRegistration = sq.NotEq{"status": []entity.RegistrationStatus{
+	entity.RegistrationStatusCancelled, entity.RegistrationStatusExpired,
+}}
+
+// seatHolding filtra as inscrições que ocupam vaga na atividade
+var seatHolding = sq.Eq{"status": []entity.RegistrationStatus{
+	entity.RegistrationStatusConfirmed, entity.RegistrationStatusPendingConfirmation,
+}}
+
+type PostgresRegistrationRepository struct {
+	db shared.DBTX
+}
+
+func NewPostgresRegistrationRepository(db shared.DBTX) *PostgresRegistrationRepository {
+	return &PostgresRegistrationRepository{db: db}
+}
+
+// WithTx retorna uma nova instância do repositório usando a transação fornecida
+func (r *PostgresRegistrationRepository) WithTx(tx *sqlx.Tx) *PostgresRegistrationRepository {
+	return &PostgresRegistrationRepository{db: tx}
+}
+
+func (r *PostgresRegistrationRepository) Save(ctx context.Context, registration *entity.Registration) (*entity.Registration, error) {
+	query, args, err := psql.
+		Insert("registrations").
+		Columns(registrationColumns...).
+		Values(registration.ID, registration.UserID, registration.EventID, registration.ActivityID,
+			registration.Status, registration.CreatedAt, registration.CancelledAt, registration.ConfirmationDeadline).
+		Suffix("RETURNING " + strings.Join(registrationColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.Registration
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		// inscrições simultâneas passam pela verificação do use case, o índice único barra a segunda
+		if shared.IsUniqueViolation(err) {
+			return nil, entity.ErrAlreadyRegistered
+		}
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func (r *PostgresRegistrationRepository) FindByID(ctx context.Context, id string) (*entity.Registration, error) {
+	return r.findByID(ctx, id, false)
+}
+
+// FindByIDForUpdate busca a inscrição bloqueando a linha até o fim da transação
+func (r *PostgresRegistrationRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.Registration, error) {
+	return r.findByID(ctx, id, true)
+}
+
+func (r *PostgresRegistrationRepository) findByID(ctx context.Context, id string, forUpdate bool) (*entity.Registration, error) {
+	builder := psql.
+		Select(registrationColumns...).
+		From("registrations").
+		Where(sq.Eq{"id": id})
+
+	if forUpdate {
+		builder = builder.Suffix("FOR UPDATE")
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.Registration
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func (r *PostgresRegistrationRepository) FindByUserAndEvent(ctx context.Context, userID, eventID string) ([]*entity.Registration, error) {
+	query, args, err := psql.
+		Select(registrationColumns...).
+		From("registrations").
+		Where(sq.Eq{"user_id": userID, "event_id": eventID}).
+		Where(activeRegistration).
+		OrderBy("created_at ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []entity.Registration
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Registration, len(rows))
+	for i := range rows {
+		result[i] = &rows[i]
+	}
+
+	return result, nil
+}
+
+func (r *PostgresRegistrationRepository) CountSeatsByActivityID(ctx context.Context, activityID string) (int, error) {
+	return r.countByActivityID(ctx, activityID, seatHolding)
+}
+
+func (r *PostgresRegistrationRepository) CountWaitlistedByActivityID(ctx context.Context, activityID string) (int, error) {
+	return r.countByActivityID(ctx, activityID, sq.Eq{"status": entity.RegistrationStatusWaitlisted})
+}
+
+func (r *PostgresRegistrationRepository) countByActivityID(ctx context.Context, activityID string, status sq.Sqlizer) (int, error) {
+	query, args, err := psql.
+		Select("COUNT(*)").
+		From("registrations").
+		Where(sq.Eq{"activity_id": activityID}).
+		Where(status).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *PostgresRegistrationRepository) FindWaitlisted(ctx context.Context, activityID string, limit int) ([]*entity.Registration, error) {
+	query, args, err := psql.
+		Select(registrationColumns...).
+		From("registrations").
+		Where(sq.Eq{"activity_id": activityID, "status": entity.RegistrationStatusWaitlisted}).
+		OrderBy("created_at ASC", "id ASC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []entity.Registration
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Registration, len(rows))
+	for i := range rows {
+		result[i] = &rows[i]
+	}
+
+	return result, nil
+}
+
+func (r *PostgresRegistrationRepository) FindActivityIDsWithWaitlist(ctx context.Context, now time.Time) ([]string, error) {
+	query, args, err := psql.
+		Select("activity_id").
+		Distinct().
+		From("registrations").
+		Where(sq.Or{
+			sq.Eq{"status": entity.RegistrationStatusWaitlisted},
+			sq.And{
+				sq.Eq{"status": entity.RegistrationStatusPendingConfirmation},
+				sq.Lt{"confirmation_deadline": now},
+			},
+		}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var activityIDs []string
+	if err := r.db.SelectContext(ctx, &activityIDs, query, args...); err != nil {
+		return nil, err
+	}
+
+	return activityIDs, nil
+}
+
+func (r *PostgresRegistrationRepository) ExpireOffers(ctx context.Context, activityID string, now time.Time) (int64, error) {
+	query, args, err := psql.
+		Update("registrations").
+		Set("status", entity.RegistrationStatusExpired).
+		Where(sq.Eq{"activity_id": activityID, "status": entity.RegistrationStatusPendingConfirmation}).
+		Where(sq.Lt{"confirmation_deadline": now}).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (r *PostgresRegistrationRepository) UpdateStatus(ctx context.Context, registration *entity.Registration) (*entity.Registration, error) {
+	query, args, err := psql.
+		Update("registrations").
+		Set("status", registration.Status).
+		Set("cancelled_at", registration.CancelledAt).
+		Set("confirmation_deadline", registration.ConfirmationDeadline).
+		Where(sq.Eq{"id": registration.ID}).
+		Suffix("RETURNING " + strings.Join(registrationColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.Registration
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func (r *PostgresRegistrationRepository) CancelByUserAndEvent(ctx context.Context, userID, eventID string, at time.Time) (int64, error) {
+	query, args, err := psql.
+		Update("registrations").
+		Set("status", entity.RegistrationStatusCancelled).
+		Set("cancelled_at", at).
+		Where(sq.Eq{"user_id": userID, "event_id": eventID}).
+		Where(activeRegistration).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
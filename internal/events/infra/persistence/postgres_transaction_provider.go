@@ -5,16 +5,52 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
 	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
 	"github.com/jmoiron/sqlx"
 )
 
+// TxCertificateQueue é uma fila de certificados que consegue publicar jobs dentro de uma transação
+type TxCertificateQueue interface {
+	WithTx(tx *sqlx.Tx) queue.CertificateEnqueuer
+}
+
 type PostgresTransactionProvider struct {
-	db *sqlx.DB
+	db               *sqlx.DB
+	certificateQueue TxCertificateQueue
 }
 
 func NewPostgresTransactionProvider(db *sqlx.DB) *PostgresTransactionProvider {
-	return &PostgresTransactionProvider{db: db}
+	return &PostgresTransactionProvider{db: db, certificateQueue: nil}
+}
+
+// WithCertificateQueue retorna um provider que expõe a fila em Repositories.CertificateQueue
+// quando ela aceita participar da transação (driver postgres); as demais filas são ignoradas
+func (p *PostgresTransactionProvider) WithCertificateQueue(certificateQueue queue.CertificateQueue) *PostgresTransactionProvider {
+	txQueue, ok := certificateQueue.(TxCertificateQueue)
+	if !ok {
+		return p
+	}
+
+	return &PostgresTransactionProvider{db: p.db, certificateQueue: txQueue}
+}
+
+// repositories monta os repositórios ligados à transação
+func (p *PostgresTransactionProvider) repositories(tx *sqlx.Tx) repository.Repositories {
+	var certificateQueue queue.CertificateEnqueuer
+	if p.certificateQueue != nil {
+		certificateQueue = p.certificateQueue.WithTx(tx)
+	}
+
+	return repository.Repositories{
+		Events:           NewPostgresEventRepository(tx),
+		Activities:       NewPostgresActivityRepository(tx),
+		CheckIns:         NewPostgresCheckInRepository(tx),
+		Registrations:    NewPostgresRegistrationRepository(tx),
+		CertificateJobs:  NewPostgresCertificateJobRepository(tx),
+		Certificates:     NewPostgresCertificateRepository(tx),
+		CertificateQueue: certificateQueue,
+	}
 }
 
 func (p *PostgresTransactionProvider) Transact(ctx context.Context, fn func(repos repository.Repositories) error) error {
@@ -23,11 +59,7 @@ func (p *PostgresTransactionProvider) Transact(ctx context.Context, fn func(repo
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
-	repos := repository.Repositories{
-		Events:     NewPostgresEventRepository(tx),
-		Activities: NewPostgresActivityRepository(tx),
-		CheckIns:   NewPostgresCheckInRepository(tx),
-	}
+	repos := p.repositories(tx)
 
 	if err := fn(repos); err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
@@ -52,11 +84,7 @@ func TransactResult[T any](p *PostgresTransactionProvider, ctx context.Context,
 		return result, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
-	repos := repository.Repositories{
-		Events:     NewPostgresEventRepository(tx),
-		Activities: NewPostgresActivityRepository(tx),
-		CheckIns:   NewPostgresCheckInRepository(tx),
-	}
+	repos := p.repositories(tx)
 
 	result, err = fn(repos)
 	if err != nil {
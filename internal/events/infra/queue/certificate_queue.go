@@ -0,0 +1,45 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/config"
+	domainqueue "github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/jmoiron/sqlx"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	DriverRedis    = "redis"
+	DriverPostgres = "postgres"
+)
+
+// NewCertificateQueueFromConfig cria a implementação definida em CERTIFICATE_QUEUE_DRIVER;
+// redisClient só é usado pelo driver redis e pode ser nil no driver postgres
+func NewCertificateQueueFromConfig(redisClient *redis.Client, db *sqlx.DB, cfg config.CertificateQueueConfig) (domainqueue.CertificateQueue, error) {
+	switch cfg.Driver {
+	case DriverRedis:
+		if redisClient == nil {
+			return nil, errors.New("redis certificate queue requires a redis client")
+		}
+		return NewRedisCertificateQueueFromConfig(redisClient, cfg), nil
+	case DriverPostgres:
+		return NewPostgresCertificateQueueFromConfig(db, cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown certificate queue driver: %s", cfg.Driver)
+	}
+}
+
+// exponentialBackoff calcula o atraso da tentativa informada, dobrando a partir de base até maxDelay
+func exponentialBackoff(base, maxDelay time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	return delay
+}
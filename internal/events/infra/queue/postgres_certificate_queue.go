@@ -0,0 +1,543 @@
+package queue
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/gabrielmatsan/checkin-gate/internal/config"
+	domainqueue "github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	defaultPollInterval = time.Second
+
+	// limita os parâmetros de cada INSERT (o Postgres aceita até 65535 por query)
+	enqueueBatchSize = 1000
+)
+
+var (
+	psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	errLeaseExpired = errors.New("visibility timeout expired")
+)
+
+// PostgresCertificateQueueConfig agrupa as configurações de retry, visibilidade e polling da fila
+type PostgresCertificateQueueConfig struct {
+	MaxAttempts       int
+	BaseBackoff       time.Duration
+	MaxBackoff        time.Duration
+	VisibilityTimeout time.Duration
+	// intervalo entre as consultas enquanto a fila está vazia
+	PollInterval time.Duration
+}
+
+// PostgresCertificateQueue implementa a fila de certificados na tabela certificate_queue
+//
+// Cada job é uma linha: Dequeue reserva a próxima linha visível com SELECT ... FOR UPDATE SKIP LOCKED,
+// grava um lease_id e empurra visible_at para o fim da reserva. Se o worker morrer, a linha volta
+// a ser visível quando a reserva expira e a reserva perdida conta como tentativa. Nack reagenda o
+// job com backoff exponencial em visible_at e, esgotadas as tentativas, preenche dead_at (dead-letter).
+type PostgresCertificateQueue struct {
+	db  *sqlx.DB
+	cfg PostgresCertificateQueueConfig
+
+	// lease de cada job reservado por este processo, indexado por JobID
+	inflight sync.Map
+
+	onDeadLetter domainqueue.DeadLetterHandler
+}
+
+type certificateQueueRow struct {
+	JobID   string  `db:"job_id"`
+	Payload []byte  `db:"payload"`
+	LeaseID *string `db:"lease_id"`
+}
+
+func NewPostgresCertificateQueue(db *sqlx.DB, cfg PostgresCertificateQueueConfig) *PostgresCertificateQueue {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+
+	return &PostgresCertificateQueue{
+		db:           db,
+		cfg:          cfg,
+		inflight:     sync.Map{},
+		onDeadLetter: nil,
+	}
+}
+
+// NewPostgresCertificateQueueFromConfig cria a fila a partir das configurações de ambiente
+func NewPostgresCertificateQueueFromConfig(db *sqlx.DB, cfg config.CertificateQueueConfig) *PostgresCertificateQueue {
+	return NewPostgresCertificateQueue(db, PostgresCertificateQueueConfig{
+		MaxAttempts:       cfg.MaxAttempts,
+		BaseBackoff:       cfg.BaseBackoff,
+		MaxBackoff:        cfg.MaxBackoff,
+		VisibilityTimeout: cfg.VisibilityTimeout,
+		PollInterval:      cfg.PollInterval,
+	})
+}
+
+// Enqueue adiciona um job na fila
+func (q *PostgresCertificateQueue) Enqueue(ctx context.Context, job *domainqueue.CertificateJob) error {
+	return q.EnqueueBatch(ctx, []*domainqueue.CertificateJob{job})
+}
+
+// EnqueueBatch adiciona múltiplos jobs na fila com um INSERT por lote
+func (q *PostgresCertificateQueue) EnqueueBatch(ctx context.Context, jobs []*domainqueue.CertificateJob) error {
+	return enqueueBatch(ctx, q.db, jobs)
+}
+
+// WithTx retorna um enqueuer que grava os jobs na transação fornecida
+func (q *PostgresCertificateQueue) WithTx(tx *sqlx.Tx) domainqueue.CertificateEnqueuer {
+	return &postgresTxEnqueuer{tx: tx}
+}
+
+// postgresTxEnqueuer publica os jobs na fila dentro de uma transação de quem chama
+type postgresTxEnqueuer struct {
+	tx *sqlx.Tx
+}
+
+func (e *postgresTxEnqueuer) EnqueueBatch(ctx context.Context, jobs []*domainqueue.CertificateJob) error {
+	return enqueueBatch(ctx, e.tx, jobs)
+}
+
+// enqueueBatch insere os jobs em certificate_queue
+// Um job publicado de novo só substitui a linha existente se ela estiver na dead-letter;
+// se ainda estiver na fila ou reservado, o job já vai ser processado
+func enqueueBatch(ctx context.Context, db sqlx.ExecerContext, jobs []*domainqueue.CertificateJob) error {
+	now := time.Now()
+
+	for batch := range slices.Chunk(jobs, enqueueBatchSize) {
+		insert := psql.
+			Insert("certificate_queue").
+			Columns("job_id", "payload", "attempts", "visible_at", "enqueued_at").
+			Suffix(`ON CONFLICT (job_id) DO UPDATE SET
+				payload = EXCLUDED.payload,
+				attempts = EXCLUDED.attempts,
+				visible_at = EXCLUDED.visible_at,
+				enqueued_at = EXCLUDED.enqueued_at,
+				lease_id = NULL,
+				dead_at = NULL
+			WHERE certificate_queue.dead_at IS NOT NULL`)
+
+		for _, job := range batch {
+			data, err := json.Marshal(job)
+			if err != nil {
+				return fmt.Errorf("failed to marshal job: %w", err)
+			}
+			insert = insert.Values(job.JobID, data, job.GetAttempts(), now, now)
+		}
+
+		query, args, err := insert.ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err := db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to enqueue jobs: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Dequeue reserva e retorna o próximo job da fila (blocking)
+func (q *PostgresCertificateQueue) Dequeue(ctx context.Context) (*domainqueue.CertificateJob, error) {
+	return q.DequeueWithTimeout(ctx, 0) // 0 = block indefinitely
+}
+
+// DequeueWithTimeout reserva o próximo job visível, consultando a tabela a cada PollInterval
+// até encontrar um job ou o timeout acabar (retorna nil se timeout)
+func (q *PostgresCertificateQueue) DequeueWithTimeout(ctx context.Context, timeout time.Duration) (*domainqueue.CertificateJob, error) {
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for {
+		job, err := q.reserve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if job != nil {
+			return job, nil
+		}
+
+		wait := q.cfg.PollInterval
+		if timeout > 0 {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return nil, nil // timeout, no job available
+			}
+			wait = min(wait, remaining)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve reserva o próximo job visível; retorna nil se não houver nenhum
+// Jobs com reserva expirada que esgotaram as tentativas vão para a dead-letter e o próximo é buscado
+func (q *PostgresCertificateQueue) reserve(ctx context.Context) (*domainqueue.CertificateJob, error) {
+	for {
+		var (
+			job       *domainqueue.CertificateJob
+			leaseID   string
+			dead      *domainqueue.CertificateJob
+			decodeErr error
+		)
+
+		err := q.transact(ctx, func(tx *sqlx.Tx) error {
+			now := time.Now()
+
+			query, args, err := psql.
+				Select("job_id", "payload", "lease_id").
+				From("certificate_queue").
+				Where(sq.Eq{"dead_at": nil}).
+				Where(sq.LtOrEq{"visible_at": now}).
+				OrderBy("visible_at ASC", "enqueued_at ASC").
+				Limit(1).
+				Suffix("FOR UPDATE SKIP LOCKED").
+				ToSql()
+			if err != nil {
+				return err
+			}
+
+			var row certificateQueueRow
+			if err := tx.GetContext(ctx, &row, query, args...); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil
+				}
+				return fmt.Errorf("failed to dequeue job: %w", err)
+			}
+
+			var decoded domainqueue.CertificateJob
+			if err := json.Unmarshal(row.Payload, &decoded); err != nil {
+				// payload inválido nunca vai ser processado, vai direto para a dead-letter
+				decodeErr = fmt.Errorf("failed to unmarshal job: %w", err)
+				_, err := q.update(ctx, tx, row.JobID, nil, map[string]any{"lease_id": nil, "dead_at": now})
+				return err
+			}
+
+			// a reserva anterior expirou: conta como tentativa, para que um job que
+			// derruba o worker não fique em loop para sempre
+			if row.LeaseID != nil {
+				decoded.RecordFailure(errLeaseExpired)
+				if decoded.GetAttempts() >= q.cfg.MaxAttempts {
+					dead = &decoded
+					_, err := q.update(ctx, tx, row.JobID, &decoded, map[string]any{"lease_id": nil, "dead_at": now})
+					return err
+				}
+			}
+
+			leaseID, err = lib.GenerateID(lib.UUID)
+			if err != nil {
+				return fmt.Errorf("failed to generate lease ID: %w", err)
+			}
+
+			if _, err := q.update(ctx, tx, row.JobID, &decoded, map[string]any{
+				"lease_id":   leaseID,
+				"visible_at": now.Add(q.cfg.VisibilityTimeout),
+			}); err != nil {
+				return err
+			}
+
+			job = &decoded
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case decodeErr != nil:
+			return nil, decodeErr
+		case dead != nil:
+			if q.onDeadLetter != nil {
+				q.onDeadLetter(ctx, dead, errLeaseExpired)
+			}
+			continue
+		case job != nil:
+			q.inflight.Store(job.JobID, leaseID)
+		}
+
+		return job, nil
+	}
+}
+
+// Ack remove o job da fila
+func (q *PostgresCertificateQueue) Ack(ctx context.Context, job *domainqueue.CertificateJob) error {
+	leaseID, ok := q.inflight.LoadAndDelete(job.JobID)
+	if !ok {
+		return fmt.Errorf("job %s is not in flight", job.JobID)
+	}
+
+	query, args, err := psql.
+		Delete("certificate_queue").
+		Where(sq.Eq{"job_id": job.JobID, "lease_id": leaseID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := q.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to ack job: %w", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to ack job: %w", err)
+	}
+
+	// nenhuma linha removida: a reserva expirou e o job foi reservado de novo
+	if deleted == 0 {
+		return domainqueue.ErrLeaseLost
+	}
+
+	return nil
+}
+
+// ExtendLease adia a visibilidade do job enquanto a reserva ainda pertence a este worker
+func (q *PostgresCertificateQueue) ExtendLease(ctx context.Context, job *domainqueue.CertificateJob) error {
+	leaseID, ok := q.inflight.Load(job.JobID)
+	if !ok {
+		return fmt.Errorf("job %s is not in flight", job.JobID)
+	}
+
+	updated, err := q.update(ctx, q.db, job.JobID, nil,
+		map[string]any{"visible_at": time.Now().Add(q.cfg.VisibilityTimeout)},
+		sq.Eq{"lease_id": leaseID, "dead_at": nil},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to extend job lease: %w", err)
+	}
+	if updated == 0 {
+		return domainqueue.ErrLeaseLost
+	}
+
+	return nil
+}
+
+// Nack incrementa as tentativas do job e o reagenda com backoff exponencial
+// Ao atingir MaxAttempts, o job vai para a dead-letter
+func (q *PostgresCertificateQueue) Nack(ctx context.Context, job *domainqueue.CertificateJob, cause error) (bool, error) {
+	leaseID, ok := q.inflight.LoadAndDelete(job.JobID)
+	if !ok {
+		return false, fmt.Errorf("job %s is not in flight", job.JobID)
+	}
+
+	job.RecordFailure(cause)
+
+	now := time.Now()
+	dead := job.GetAttempts() >= q.cfg.MaxAttempts
+	set := map[string]any{"lease_id": nil, "dead_at": now}
+	if !dead {
+		set = map[string]any{"lease_id": nil, "visible_at": now.Add(q.backoff(job.GetAttempts()))}
+	}
+
+	updated, err := q.update(ctx, q.db, job.JobID, job, set, sq.Eq{"lease_id": leaseID})
+	if err != nil {
+		return false, fmt.Errorf("failed to nack job: %w", err)
+	}
+
+	// nenhuma linha alterada: a reserva expirou e o job foi reservado de novo
+	if updated == 0 {
+		return false, domainqueue.ErrLeaseLost
+	}
+
+	return dead, nil
+}
+
+// ListDeadLetters retorna os jobs mais recentes da dead-letter
+func (q *PostgresCertificateQueue) ListDeadLetters(ctx context.Context, limit int64) ([]*domainqueue.CertificateJob, error) {
+	query, args, err := psql.
+		Select("payload").
+		From("certificate_queue").
+		Where(sq.NotEq{"dead_at": nil}).
+		OrderBy("dead_at DESC").
+		Limit(uint64(max(limit, 0))).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var payloads [][]byte
+	if err := q.db.SelectContext(ctx, &payloads, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	jobs := make([]*domainqueue.CertificateJob, 0, len(payloads))
+	for _, payload := range payloads {
+		var job domainqueue.CertificateJob
+		if err := json.Unmarshal(payload, &job); err != nil {
+			continue
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, nil
+}
+
+// ReplayDeadLetter devolve o job da dead-letter para a fila com as tentativas zeradas
+func (q *PostgresCertificateQueue) ReplayDeadLetter(ctx context.Context, jobID string) (*domainqueue.CertificateJob, error) {
+	var job domainqueue.CertificateJob
+
+	err := q.transact(ctx, func(tx *sqlx.Tx) error {
+		query, args, err := psql.
+			Select("payload").
+			From("certificate_queue").
+			Where(sq.Eq{"job_id": jobID}).
+			Where(sq.NotEq{"dead_at": nil}).
+			Suffix("FOR UPDATE").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		var payload []byte
+		if err := tx.GetContext(ctx, &payload, query, args...); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return domainqueue.ErrJobNotFound
+			}
+			return fmt.Errorf("failed to find dead letter: %w", err)
+		}
+
+		if err := json.Unmarshal(payload, &job); err != nil {
+			return fmt.Errorf("failed to unmarshal job: %w", err)
+		}
+
+		job.ResetAttempts()
+
+		_, err = q.update(ctx, tx, jobID, &job, map[string]any{"lease_id": nil, "dead_at": nil, "visible_at": time.Now()})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// RemoveDeadLetters remove da dead-letter os jobs informados
+func (q *PostgresCertificateQueue) RemoveDeadLetters(ctx context.Context, jobIDs []string) error {
+	if len(jobIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := psql.
+		Delete("certificate_queue").
+		Where(sq.Eq{"job_id": jobIDs}).
+		Where(sq.NotEq{"dead_at": nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := q.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to remove dead letters: %w", err)
+	}
+
+	return nil
+}
+
+// OnDeadLetter registra o aviso dos jobs movidos para a dead-letter por reservas expiradas
+func (q *PostgresCertificateQueue) OnDeadLetter(handler domainqueue.DeadLetterHandler) {
+	q.onDeadLetter = handler
+}
+
+// Len retorna quantos jobs estão prontos para processamento
+func (q *PostgresCertificateQueue) Len(ctx context.Context) (int64, error) {
+	query, args, err := psql.
+		Select("COUNT(*)").
+		From("certificate_queue").
+		Where(sq.Eq{"dead_at": nil, "lease_id": nil}).
+		Where(sq.LtOrEq{"visible_at": time.Now()}).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var length int64
+	if err := q.db.GetContext(ctx, &length, query, args...); err != nil {
+		return 0, fmt.Errorf("failed to get queue length: %w", err)
+	}
+
+	return length, nil
+}
+
+// update grava as colunas de set no job e, se job não for nil, o payload e as tentativas;
+// retorna quantas linhas foram alteradas
+func (q *PostgresCertificateQueue) update(ctx context.Context, db sqlx.ExecerContext, jobID string, job *domainqueue.CertificateJob, set map[string]any, where ...sq.Eq) (int64, error) {
+	builder := psql.
+		Update("certificate_queue").
+		SetMap(set).
+		Where(sq.Eq{"job_id": jobID})
+
+	for _, w := range where {
+		builder = builder.Where(w)
+	}
+
+	if job != nil {
+		data, err := json.Marshal(job)
+		if err != nil {
+			return 0, fmt.Errorf("failed to marshal job: %w", err)
+		}
+		builder = builder.Set("payload", data).Set("attempts", job.GetAttempts())
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (q *PostgresCertificateQueue) transact(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	tx, err := q.db.BeginTxx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx error: %v, rollback error: %w", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// backoff calcula o atraso exponencial para a tentativa informada
+func (q *PostgresCertificateQueue) backoff(attempt int) time.Duration {
+	return exponentialBackoff(q.cfg.BaseBackoff, q.cfg.MaxBackoff, attempt)
+}
+
+// Compile-time check to ensure PostgresCertificateQueue implements CertificateQueue
+var _ domainqueue.CertificateQueue = (*PostgresCertificateQueue)(nil)
@@ -3,25 +3,159 @@ package queue
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
+	"github.com/gabrielmatsan/checkin-gate/internal/config"
 	domainqueue "github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
 	"github.com/redis/go-redis/v9"
 )
 
-const certificateQueueKey = "certificate:jobs"
+const (
+	defaultCertificateQueueKey = "certificate:jobs"
+
+	// quantidade máxima de itens movidos por chamada dos scripts de manutenção
+	requeueBatchSize = 100
+)
+
+// Estrutura das chaves no Redis (com key = certificate:jobs):
+//
+//	key             LIST  jobs prontos para processamento (LPUSH / BLMOVE pela direita)
+//	key:processing  LIST  jobs reservados por algum worker
+//	key:leases      ZSET  payload -> prazo (unix ms) da reserva em processing
+//	key:delayed     ZSET  payload -> instante (unix ms) em que o retry fica disponível
+//	key:dead        LIST  jobs que esgotaram as tentativas
+var (
+	// promove retries vencidos para a fila e garante lease para itens
+	// em processing sem prazo (worker morreu entre o BLMOVE e o ZADD)
+	requeueScript = redis.NewScript(`
+local due = redis.call('ZRANGEBYSCORE', KEYS[3], '-inf', ARGV[1], 'LIMIT', 0, ARGV[3])
+for _, item in ipairs(due) do
+  redis.call('ZREM', KEYS[3], item)
+  redis.call('LPUSH', KEYS[1], item)
+end
+local inflight = redis.call('LRANGE', KEYS[2], 0, -1)
+for _, item in ipairs(inflight) do
+  if not redis.call('ZSCORE', KEYS[4], item) then
+    redis.call('ZADD', KEYS[4], ARGV[2], item)
+  end
+end
+return #due
+`)
+
+	// remove o payload antigo de processing e publica o novo no destino
+	// retorna 0 se o job não estava mais reservado (lease expirou e outro worker o pegou)
+	// ARGV[3]: "list" faz LPUSH em KEYS[3], "rpush" faz RPUSH, "zset" faz ZADD com score ARGV[4]
+	releaseScript = redis.NewScript(`
+if redis.call('LREM', KEYS[1], 1, ARGV[1]) == 0 then
+  return 0
+end
+redis.call('ZREM', KEYS[2], ARGV[1])
+if ARGV[3] == 'list' then
+  redis.call('LPUSH', KEYS[3], ARGV[2])
+elseif ARGV[3] == 'rpush' then
+  redis.call('RPUSH', KEYS[3], ARGV[2])
+elseif ARGV[3] == 'zset' then
+  redis.call('ZADD', KEYS[3], ARGV[4], ARGV[2])
+end
+return 1
+`)
+
+	// devolve um job com reserva expirada para a fila (RPUSH) ou para a dead-letter (ARGV[3] = "list")
+	// retorna 0 se a reserva foi renovada depois da listagem ou se o job não está mais em processing
+	reclaimScript = redis.NewScript(`
+local deadline = redis.call('ZSCORE', KEYS[2], ARGV[1])
+if deadline and tonumber(deadline) > tonumber(ARGV[4]) then
+  return 0
+end
+redis.call('ZREM', KEYS[2], ARGV[1])
+if redis.call('LREM', KEYS[1], 1, ARGV[1]) == 0 then
+  return 0
+end
+if ARGV[3] == 'list' then
+  redis.call('LPUSH', KEYS[3], ARGV[2])
+else
+  redis.call('RPUSH', KEYS[3], ARGV[2])
+end
+return 1
+`)
+
+	// renova o prazo da reserva, desde que ela ainda exista
+	extendLeaseScript = redis.NewScript(`
+if not redis.call('ZSCORE', KEYS[1], ARGV[1]) then
+  return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[2], ARGV[1])
+return 1
+`)
+
+	// move um job da dead-letter de volta para a fila
+	replayScript = redis.NewScript(`
+if redis.call('LREM', KEYS[1], 1, ARGV[1]) == 0 then
+  return 0
+end
+redis.call('LPUSH', KEYS[2], ARGV[2])
+return 1
+`)
+)
+
+// RedisCertificateQueueConfig agrupa as configurações de retry e visibilidade da fila
+type RedisCertificateQueueConfig struct {
+	Key               string
+	MaxAttempts       int
+	BaseBackoff       time.Duration
+	MaxBackoff        time.Duration
+	VisibilityTimeout time.Duration
+}
 
 type RedisCertificateQueue struct {
 	client *redis.Client
+	cfg    RedisCertificateQueueConfig
+
+	key           string
+	processingKey string
+	leasesKey     string
+	delayedKey    string
+	deadKey       string
+
+	// payload original de cada job reservado por este processo, indexado por JobID
+	// necessário para o LREM em processing, já que o job pode ser alterado em memória
+	inflight sync.Map
+
+	onDeadLetter domainqueue.DeadLetterHandler
 }
 
-func NewRedisCertificateQueue(client *redis.Client) *RedisCertificateQueue {
+func NewRedisCertificateQueue(client *redis.Client, cfg RedisCertificateQueueConfig) *RedisCertificateQueue {
+	if cfg.Key == "" {
+		cfg.Key = defaultCertificateQueueKey
+	}
+
 	return &RedisCertificateQueue{
-		client: client,
+		client:        client,
+		cfg:           cfg,
+		key:           cfg.Key,
+		processingKey: cfg.Key + ":processing",
+		leasesKey:     cfg.Key + ":leases",
+		delayedKey:    cfg.Key + ":delayed",
+		deadKey:       cfg.Key + ":dead",
+		inflight:      sync.Map{},
+		onDeadLetter:  nil,
 	}
 }
 
+// NewRedisCertificateQueueFromConfig cria a fila a partir das configurações de ambiente
+func NewRedisCertificateQueueFromConfig(client *redis.Client, cfg config.CertificateQueueConfig) *RedisCertificateQueue {
+	return NewRedisCertificateQueue(client, RedisCertificateQueueConfig{
+		Key:               cfg.Key,
+		MaxAttempts:       cfg.MaxAttempts,
+		BaseBackoff:       cfg.BaseBackoff,
+		MaxBackoff:        cfg.MaxBackoff,
+		VisibilityTimeout: cfg.VisibilityTimeout,
+	})
+}
+
 // Enqueue adiciona um job na fila usando LPUSH
 func (q *RedisCertificateQueue) Enqueue(ctx context.Context, job *domainqueue.CertificateJob) error {
 	data, err := json.Marshal(job)
@@ -29,7 +163,7 @@ func (q *RedisCertificateQueue) Enqueue(ctx context.Context, job *domainqueue.Ce
 		return fmt.Errorf("failed to marshal job: %w", err)
 	}
 
-	if err := q.client.LPush(ctx, certificateQueueKey, data).Err(); err != nil {
+	if err := q.client.LPush(ctx, q.key, data).Err(); err != nil {
 		return fmt.Errorf("failed to enqueue job: %w", err)
 	}
 
@@ -49,7 +183,7 @@ func (q *RedisCertificateQueue) EnqueueBatch(ctx context.Context, jobs []*domain
 		if err != nil {
 			return fmt.Errorf("failed to marshal job: %w", err)
 		}
-		pipe.LPush(ctx, certificateQueueKey, data)
+		pipe.LPush(ctx, q.key, data)
 	}
 
 	_, err := pipe.Exec(ctx)
@@ -60,42 +194,306 @@ func (q *RedisCertificateQueue) EnqueueBatch(ctx context.Context, jobs []*domain
 	return nil
 }
 
-// Dequeue remove e retorna o próximo job da fila usando BRPOP (blocking)
+// Dequeue reserva e retorna o próximo job da fila usando BLMOVE (blocking)
 func (q *RedisCertificateQueue) Dequeue(ctx context.Context) (*domainqueue.CertificateJob, error) {
 	return q.DequeueWithTimeout(ctx, 0) // 0 = block indefinitely
 }
 
-// DequeueWithTimeout remove com timeout usando BRPOP
+// DequeueWithTimeout move o próximo job para a lista de processamento usando BLMOVE
+// Antes de bloquear, devolve para a fila os retries vencidos e as reservas expiradas
 func (q *RedisCertificateQueue) DequeueWithTimeout(ctx context.Context, timeout time.Duration) (*domainqueue.CertificateJob, error) {
-	result, err := q.client.BRPop(ctx, timeout, certificateQueueKey).Result()
+	if err := q.requeue(ctx); err != nil {
+		return nil, err
+	}
+
+	raw, err := q.client.BLMove(ctx, q.key, q.processingKey, "RIGHT", "LEFT", timeout).Result()
 	if err != nil {
-		if err == redis.Nil {
+		if errors.Is(err, redis.Nil) {
 			return nil, nil // timeout, no job available
 		}
 		return nil, fmt.Errorf("failed to dequeue job: %w", err)
 	}
 
-	// BRPop returns [key, value]
-	if len(result) < 2 {
-		return nil, fmt.Errorf("unexpected BRPop result: %v", result)
+	lease := time.Now().Add(q.cfg.VisibilityTimeout).UnixMilli()
+	if err := q.client.ZAdd(ctx, q.leasesKey, redis.Z{Score: float64(lease), Member: raw}).Err(); err != nil {
+		return nil, fmt.Errorf("failed to set job lease: %w", err)
 	}
 
 	var job domainqueue.CertificateJob
-	if err := json.Unmarshal([]byte(result[1]), &job); err != nil {
+	if err := json.Unmarshal([]byte(raw), &job); err != nil {
+		// payload inválido nunca vai ser processado, vai direto para a dead-letter
+		if _, rErr := releaseScript.Run(ctx, q.client, []string{q.processingKey, q.leasesKey, q.deadKey}, raw, raw, "list").Result(); rErr != nil {
+			return nil, fmt.Errorf("failed to unmarshal job: %v, dead-letter error: %w", err, rErr)
+		}
 		return nil, fmt.Errorf("failed to unmarshal job: %w", err)
 	}
 
+	q.inflight.Store(job.JobID, raw)
+
 	return &job, nil
 }
 
+// Ack remove o job da lista de processamento
+func (q *RedisCertificateQueue) Ack(ctx context.Context, job *domainqueue.CertificateJob) error {
+	raw, ok := q.inflight.LoadAndDelete(job.JobID)
+	if !ok {
+		return fmt.Errorf("job %s is not in flight", job.JobID)
+	}
+
+	moved, err := releaseScript.Run(ctx, q.client, []string{q.processingKey, q.leasesKey, q.processingKey}, raw, "", "none").Int()
+	if err != nil {
+		return fmt.Errorf("failed to ack job: %w", err)
+	}
+
+	// o job não estava mais reservado: a reserva expirou e ele voltou para a fila
+	if moved == 0 {
+		return domainqueue.ErrLeaseLost
+	}
+
+	return nil
+}
+
+// ExtendLease renova o prazo da reserva do job em processing
+func (q *RedisCertificateQueue) ExtendLease(ctx context.Context, job *domainqueue.CertificateJob) error {
+	raw, ok := q.inflight.Load(job.JobID)
+	if !ok {
+		return fmt.Errorf("job %s is not in flight", job.JobID)
+	}
+
+	lease := time.Now().Add(q.cfg.VisibilityTimeout).UnixMilli()
+	extended, err := extendLeaseScript.Run(ctx, q.client, []string{q.leasesKey}, raw, lease).Int()
+	if err != nil {
+		return fmt.Errorf("failed to extend job lease: %w", err)
+	}
+	if extended == 0 {
+		return domainqueue.ErrLeaseLost
+	}
+
+	return nil
+}
+
+// Nack incrementa as tentativas do job e o reagenda com backoff exponencial
+// Ao atingir MaxAttempts, o job vai para a dead-letter
+func (q *RedisCertificateQueue) Nack(ctx context.Context, job *domainqueue.CertificateJob, cause error) (bool, error) {
+	raw, ok := q.inflight.LoadAndDelete(job.JobID)
+	if !ok {
+		return false, fmt.Errorf("job %s is not in flight", job.JobID)
+	}
+
+	job.RecordFailure(cause)
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal job: %w", err)
+	}
+
+	dead := job.GetAttempts() >= q.cfg.MaxAttempts
+	keys := []string{q.processingKey, q.leasesKey, q.deadKey}
+	args := []any{raw, data, "list"}
+
+	if !dead {
+		retryAt := time.Now().Add(q.backoff(job.GetAttempts())).UnixMilli()
+		keys = []string{q.processingKey, q.leasesKey, q.delayedKey}
+		args = []any{raw, data, "zset", retryAt}
+	}
+
+	moved, err := releaseScript.Run(ctx, q.client, keys, args...).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to nack job: %w", err)
+	}
+
+	// o job não estava mais reservado: a reserva expirou e ele voltou para a fila
+	if moved == 0 {
+		return false, domainqueue.ErrLeaseLost
+	}
+
+	return dead, nil
+}
+
+// ListDeadLetters retorna os jobs mais recentes da dead-letter
+func (q *RedisCertificateQueue) ListDeadLetters(ctx context.Context, limit int64) ([]*domainqueue.CertificateJob, error) {
+	items, err := q.client.LRange(ctx, q.deadKey, 0, limit-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	jobs := make([]*domainqueue.CertificateJob, 0, len(items))
+	for _, item := range items {
+		var job domainqueue.CertificateJob
+		if err := json.Unmarshal([]byte(item), &job); err != nil {
+			continue
+		}
+		jobs = append(jobs, &job)
+	}
+
+	return jobs, nil
+}
+
+// ReplayDeadLetter devolve o job da dead-letter para a fila com as tentativas zeradas
+func (q *RedisCertificateQueue) ReplayDeadLetter(ctx context.Context, jobID string) (*domainqueue.CertificateJob, error) {
+	items, err := q.client.LRange(ctx, q.deadKey, 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	for _, item := range items {
+		var job domainqueue.CertificateJob
+		if err := json.Unmarshal([]byte(item), &job); err != nil || job.JobID != jobID {
+			continue
+		}
+
+		job.ResetAttempts()
+
+		data, err := json.Marshal(&job)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal job: %w", err)
+		}
+
+		moved, err := replayScript.Run(ctx, q.client, []string{q.deadKey, q.key}, item, data).Int()
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay job: %w", err)
+		}
+		if moved == 0 {
+			return nil, domainqueue.ErrJobNotFound
+		}
+
+		return &job, nil
+	}
+
+	return nil, domainqueue.ErrJobNotFound
+}
+
+// RemoveDeadLetters remove da dead-letter os jobs informados
+func (q *RedisCertificateQueue) RemoveDeadLetters(ctx context.Context, jobIDs []string) error {
+	if len(jobIDs) == 0 {
+		return nil
+	}
+
+	ids := make(map[string]struct{}, len(jobIDs))
+	for _, id := range jobIDs {
+		ids[id] = struct{}{}
+	}
+
+	items, err := q.client.LRange(ctx, q.deadKey, 0, -1).Result()
+	if err != nil {
+		return fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	pipe := q.client.Pipeline()
+	removed := 0
+	for _, item := range items {
+		var job domainqueue.CertificateJob
+		if err := json.Unmarshal([]byte(item), &job); err != nil {
+			continue
+		}
+		if _, ok := ids[job.JobID]; !ok {
+			continue
+		}
+		pipe.LRem(ctx, q.deadKey, 1, item)
+		removed++
+	}
+
+	if removed == 0 {
+		return nil
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to remove dead letters: %w", err)
+	}
+
+	return nil
+}
+
 // Len retorna o tamanho atual da fila
 func (q *RedisCertificateQueue) Len(ctx context.Context) (int64, error) {
-	length, err := q.client.LLen(ctx, certificateQueueKey).Result()
+	length, err := q.client.LLen(ctx, q.key).Result()
 	if err != nil {
 		return 0, fmt.Errorf("failed to get queue length: %w", err)
 	}
 	return length, nil
 }
 
+// requeue promove os retries vencidos e recupera jobs cuja reserva expirou
+func (q *RedisCertificateQueue) requeue(ctx context.Context) error {
+	now := time.Now()
+	lease := now.Add(q.cfg.VisibilityTimeout).UnixMilli()
+
+	keys := []string{q.key, q.processingKey, q.delayedKey, q.leasesKey}
+	if err := requeueScript.Run(ctx, q.client, keys, now.UnixMilli(), lease, requeueBatchSize).Err(); err != nil {
+		return fmt.Errorf("failed to promote delayed jobs: %w", err)
+	}
+
+	expired, err := q.client.ZRangeByScore(ctx, q.leasesKey, &redis.ZRangeBy{
+		Min:    "-inf",
+		Max:    fmt.Sprint(now.UnixMilli()),
+		Offset: 0,
+		Count:  requeueBatchSize,
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("failed to list expired leases: %w", err)
+	}
+
+	for _, raw := range expired {
+		if err := q.reclaim(ctx, raw, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reclaim devolve para a fila um job cujo worker não respondeu dentro do prazo
+// A reserva expirada conta como tentativa, para que um job que derruba o worker
+// não fique em loop para sempre
+func (q *RedisCertificateQueue) reclaim(ctx context.Context, raw string, now time.Time) error {
+	var job domainqueue.CertificateJob
+	if err := json.Unmarshal([]byte(raw), &job); err != nil {
+		_, err := releaseScript.Run(ctx, q.client, []string{q.processingKey, q.leasesKey, q.deadKey}, raw, raw, "list").Result()
+		return err
+	}
+
+	cause := errors.New("visibility timeout expired")
+	job.RecordFailure(cause)
+
+	data, err := json.Marshal(&job)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job: %w", err)
+	}
+
+	// volta para a direita da fila, para ser o próximo a ser consumido
+	dead := job.GetAttempts() >= q.cfg.MaxAttempts
+	keys := []string{q.processingKey, q.leasesKey, q.key}
+	args := []any{raw, data, "rpush", now.UnixMilli()}
+	if dead {
+		keys = []string{q.processingKey, q.leasesKey, q.deadKey}
+		args = []any{raw, data, "list", now.UnixMilli()}
+	}
+
+	// o script confere o prazo de novo: o worker pode ter renovado a reserva depois da listagem
+	moved, err := reclaimScript.Run(ctx, q.client, keys, args...).Int()
+	if err != nil {
+		return fmt.Errorf("failed to reclaim job: %w", err)
+	}
+	if moved == 0 {
+		return nil
+	}
+
+	if dead && q.onDeadLetter != nil {
+		q.onDeadLetter(ctx, &job, cause)
+	}
+
+	return nil
+}
+
+// OnDeadLetter registra o aviso dos jobs movidos para a dead-letter pela recuperação de reservas expiradas
+func (q *RedisCertificateQueue) OnDeadLetter(handler domainqueue.DeadLetterHandler) {
+	q.onDeadLetter = handler
+}
+
+// backoff calcula o atraso exponencial para a tentativa informada
+func (q *RedisCertificateQueue) backoff(attempt int) time.Duration {
+	return exponentialBackoff(q.cfg.BaseBackoff, q.cfg.MaxBackoff, attempt)
+}
+
 // Compile-time check to ensure RedisCertificateQueue implements CertificateQueue
 var _ domainqueue.CertificateQueue = (*RedisCertificateQueue)(nil)
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"sync"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/mail"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxConcurrentNotifications limita os envios simultâneos ao provedor de email
+const maxConcurrentNotifications = 5
+
+type EmailAttendeeNotifier struct {
+	emailService mail.EmailService
+}
+
+func NewEmailAttendeeNotifier(emailService mail.EmailService) *EmailAttendeeNotifier {
+	return &EmailAttendeeNotifier{
+		emailService: emailService,
+	}
+}
+
+func (n *EmailAttendeeNotifier) NotifyEventCancelled(ctx context.Context, event *entity.Event, reason string, attendees []*service.UserInfo) (int, error) {
+	return n.sendAll(ctx, attendees, func(attendee *service.UserInfo) mail.SendEmailParams {
+		return mail.SendEmailParams{
+			To:          attendee.Email,
+			Subject:     fmt.Sprintf("Evento cancelado - %s", event.Name),
+			Body:        buildEventCancelledEmailBody(attendee.FirstName, event.Name, reason),
+			Attachments: nil,
+		}
+	})
+}
+
+func (n *EmailAttendeeNotifier) NotifyWaitlistPromotion(ctx context.Context, event *entity.Event, activity *entity.Activity, deadline time.Time, attendees []*service.UserInfo) (int, error) {
+	return n.sendAll(ctx, attendees, func(attendee *service.UserInfo) mail.SendEmailParams {
+		return mail.SendEmailParams{
+			To:          attendee.Email,
+			Subject:     fmt.Sprintf("Vaga disponível - %s", activity.Name),
+			Body:        buildWaitlistPromotionEmailBody(attendee.FirstName, event.Name, activity.Name, deadline),
+			Attachments: nil,
+		}
+	})
+}
+
+// sendAll envia um email para cada participante em paralelo e retorna quantos foram enviados
+func (n *EmailAttendeeNotifier) sendAll(ctx context.Context, attendees []*service.UserInfo, build func(attendee *service.UserInfo) mail.SendEmailParams) (int, error) {
+	var (
+		mu   sync.Mutex
+		sent int
+		errs []error
+	)
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentNotifications)
+
+	for _, attendee := range attendees {
+		g.Go(func() error {
+			err := n.emailService.Send(gCtx, build(attendee))
+
+			mu.Lock()
+			defer mu.Unlock()
+			// uma falha não interrompe os demais envios
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to notify %s: %w", attendee.Email, err))
+				return nil
+			}
+			sent++
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return sent, err
+	}
+
+	return sent, errors.Join(errs...)
+}
+
+// buildEventCancelledEmailBody constrói o corpo HTML do aviso de cancelamento
+func buildEventCancelledEmailBody(name, eventName, reason string) string {
+	reasonParagraph := ""
+	if reason != "" {
+		reasonParagraph = fmt.Sprintf("<p>Motivo: %s</p>", html.EscapeString(reason))
+	}
+
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #DC2626; color: white; padding: 20px; text-align: center; border-radius: 8px 8px 0 0; }
+        .content { background-color: #f9fafb; padding: 30px; border-radius: 0 0 8px 8px; }
+        .footer { text-align: center; margin-top: 20px; color: #666; font-size: 12px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>Evento cancelado</h1>
+        </div>
+        <div class="content">
+            <p>Olá, <strong>%s</strong>!</p>
+            <p>Informamos que o evento <strong>%s</strong>, do qual você participou, foi cancelado.</p>
+            %s
+            <p>Os check-ins realizados ficam registrados e, caso o evento seja reaberto, você será considerado(a) normalmente.</p>
+            <p>Atenciosamente,<br>Equipe Checkin Gate</p>
+        </div>
+        <div class="footer">
+            <p>Este é um email automático, por favor não responda.</p>
+        </div>
+    </div>
+</body>
+</html>
+`, html.EscapeString(name), html.EscapeString(eventName), reasonParagraph)
+}
+
+// buildWaitlistPromotionEmailBody constrói o corpo HTML do aviso de vaga liberada na lista de espera
+func buildWaitlistPromotionEmailBody(name, eventName, activityName string, deadline time.Time) string {
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #16A34A; color: white; padding: 20px; text-align: center; border-radius: 8px 8px 0 0; }
+        .content { background-color: #f9fafb; padding: 30px; border-radius: 0 0 8px 8px; }
+        .footer { text-align: center; margin-top: 20px; color: #666; font-size: 12px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>Vaga disponível</h1>
+        </div>
+        <div class="content">
+            <p>Olá, <strong>%s</strong>!</p>
+            <p>Abriu uma vaga na atividade <strong>%s</strong> do evento <strong>%s</strong>, e você era o(a) próximo(a) da lista de espera.</p>
+            <p>Confirme sua inscrição até <strong>%s</strong>. Depois desse prazo, a vaga passa para a próxima pessoa da fila.</p>
+            <p>Atenciosamente,<br>Equipe Checkin Gate</p>
+        </div>
+        <div class="footer">
+            <p>Este é um email automático, por favor não responda.</p>
+        </div>
+    </div>
+</body>
+</html>
+`, html.EscapeString(name), html.EscapeString(activityName), html.EscapeString(eventName), deadline.Format("02/01/2006 15:04 MST"))
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/service"
+)
+
+// tamanho do HMAC truncado usado no token (10 bytes = 16 caracteres em base32)
+const checkInTokenBytes = 10
+
+// HMACCheckInTokenService gera tokens no estilo TOTP: HMAC-SHA256 do ID da atividade
+// e da janela de tempo atual, assinado com um segredo do servidor
+type HMACCheckInTokenService struct {
+	secret []byte
+}
+
+func NewHMACCheckInTokenService(secret string) *HMACCheckInTokenService {
+	return &HMACCheckInTokenService{secret: []byte(secret)}
+}
+
+func (s *HMACCheckInTokenService) Generate(activityID string, period time.Duration, at time.Time) service.CheckInToken {
+	window := s.window(period, at)
+
+	return service.CheckInToken{
+		Value:     s.sign(activityID, window),
+		ExpiresAt: time.Unix((window+1)*int64(period/time.Second), 0),
+	}
+}
+
+// Verify aceita o token da janela atual e da anterior, para quem escaneou o QR code
+// pouco antes da rotação
+func (s *HMACCheckInTokenService) Verify(activityID, token string, period time.Duration, at time.Time) bool {
+	token = strings.ToUpper(strings.TrimSpace(token))
+	window := s.window(period, at)
+
+	for _, w := range []int64{window, window - 1} {
+		if hmac.Equal([]byte(token), []byte(s.sign(activityID, w))) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *HMACCheckInTokenService) window(period time.Duration, at time.Time) int64 {
+	seconds := int64(period / time.Second)
+	if seconds <= 0 {
+		seconds = 1
+	}
+	return at.Unix() / seconds
+}
+
+func (s *HMACCheckInTokenService) sign(activityID string, window int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(window))
+
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(activityID))
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(sum[:checkInTokenBytes])
+}
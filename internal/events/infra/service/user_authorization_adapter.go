@@ -73,3 +73,37 @@ func (a *UserAuthorizationAdapter) GetUserInfoBatch(ctx context.Context, userIDs
 	}
 	return result, nil
 }
+
+func (a *UserAuthorizationAdapter) FindUserByEmail(ctx context.Context, email string) (*service.UserInfo, error) {
+	user, err := a.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, nil
+	}
+	return &service.UserInfo{
+		ID:        user.ID,
+		IsAdmin:   user.IsAdmin(),
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+	}, nil
+}
+
+func (a *UserAuthorizationAdapter) FindUserByCPF(ctx context.Context, cpf string) (*service.UserInfo, error) {
+	user, err := a.userRepo.FindByCPF(ctx, cpf)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, nil
+	}
+	return &service.UserInfo{
+		ID:        user.ID,
+		IsAdmin:   user.IsAdmin(),
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"go.uber.org/zap"
+)
+
+// CertificateOutboxRelayConfig define o intervalo entre as varreduras do outbox
+// e quantos jobs são publicados por transação
+type CertificateOutboxRelayConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// CertificateOutboxRelay publica na fila os jobs de certificado gravados no outbox
+//
+// Com a fila no banco (driver postgres), os jobs entram na fila na mesma transação que os
+// marca como despachados. Nas demais filas a publicação é at-least-once: se o commit falhar
+// depois do EnqueueBatch, o job é publicado de novo na próxima varredura com o mesmo JobID,
+// e a emissão do certificado é idempotente por job.
+type CertificateOutboxRelay struct {
+	txProvider repository.TransactionProvider
+	queue      queue.CertificateQueue
+	logger     *zap.Logger
+	cfg        CertificateOutboxRelayConfig
+}
+
+func NewCertificateOutboxRelay(
+	txProvider repository.TransactionProvider,
+	queue queue.CertificateQueue,
+	logger *zap.Logger,
+	cfg CertificateOutboxRelayConfig,
+) *CertificateOutboxRelay {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 2 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+
+	return &CertificateOutboxRelay{
+		txProvider: txProvider,
+		queue:      queue,
+		logger:     logger,
+		cfg:        cfg,
+	}
+}
+
+// Start publica os jobs pendentes a cada intervalo até ctx ser cancelado
+func (r *CertificateOutboxRelay) Start(ctx context.Context) {
+	r.logger.Info("certificate outbox relay started",
+		zap.Duration("interval", r.cfg.Interval),
+		zap.Int("batch_size", r.cfg.BatchSize),
+	)
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("certificate outbox relay stopped")
+			return
+		case <-ticker.C:
+			r.drain(ctx)
+		}
+	}
+}
+
+// drain publica lotes até o outbox esvaziar
+func (r *CertificateOutboxRelay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		dispatched, err := r.relayBatch(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				r.logger.Error("failed to relay certificate jobs", zap.Error(err))
+			}
+			return
+		}
+
+		if dispatched > 0 {
+			r.logger.Info("certificate jobs relayed", zap.Int("jobs", dispatched))
+		}
+
+		if dispatched < r.cfg.BatchSize {
+			return
+		}
+	}
+}
+
+func (r *CertificateOutboxRelay) relayBatch(ctx context.Context) (int, error) {
+	dispatched := 0
+
+	err := r.txProvider.Transact(ctx, func(repos repository.Repositories) error {
+		jobs, err := repos.CertificateJobs.ClaimPending(ctx, r.cfg.BatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to claim outbox jobs: %w", err)
+		}
+
+		if len(jobs) == 0 {
+			return nil
+		}
+
+		var enqueuer queue.CertificateEnqueuer = r.queue
+		if repos.CertificateQueue != nil {
+			enqueuer = repos.CertificateQueue
+		}
+
+		if err := enqueuer.EnqueueBatch(ctx, jobs); err != nil {
+			return fmt.Errorf("failed to enqueue certificate jobs: %w", err)
+		}
+
+		jobIDs := make([]string, len(jobs))
+		for i, job := range jobs {
+			jobIDs[i] = job.GetJobID()
+		}
+
+		if err := repos.CertificateJobs.MarkDispatched(ctx, jobIDs, time.Now()); err != nil {
+			return fmt.Errorf("failed to mark outbox jobs as dispatched: %w", err)
+		}
+
+		dispatched = len(jobs)
+		return nil
+	})
+
+	return dispatched, err
+}
@@ -2,118 +2,289 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/entity"
 	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/queue"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
 	"github.com/gabrielmatsan/checkin-gate/internal/events/infra/pdf"
 	"github.com/gabrielmatsan/checkin-gate/internal/shared/mail"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/storage"
 	"go.uber.org/zap"
 )
 
+// CertificateWorkerConfig define a concorrência, o tempo de espera por jobs,
+// a URL base usada no link de verificação impresso no certificado
+// e o intervalo de renovação da reserva dos jobs em processamento (0 desativa)
+type CertificateWorkerConfig struct {
+	Concurrency         int
+	PollTimeout         time.Duration
+	VerifyBaseURL       string
+	LeaseExtendInterval time.Duration
+}
+
 type CertificateWorker struct {
-	queue        queue.CertificateQueue
-	generator    pdf.CertificateGenerator
-	emailService mail.EmailService
-	logger       *zap.Logger
+	queue           queue.CertificateQueue
+	generator       pdf.CertificateGenerator
+	templateLoader  *pdf.TemplateLoader
+	emailService    mail.EmailService
+	certificateRepo repository.CertificateRepository
+	jobRepo         repository.CertificateJobRepository
+	eventRepo       repository.EventRepository
+	activityRepo    repository.ActivityRepository
+	checkInRepo     repository.CheckInRepository
+	blobStorage     storage.BlobStorage
+	logger          *zap.Logger
+	cfg             CertificateWorkerConfig
+
+	inFlight atomic.Int64
 }
 
 func NewCertificateWorker(
 	queue queue.CertificateQueue,
 	generator pdf.CertificateGenerator,
+	templateLoader *pdf.TemplateLoader,
 	emailService mail.EmailService,
+	certificateRepo repository.CertificateRepository,
+	jobRepo repository.CertificateJobRepository,
+	eventRepo repository.EventRepository,
+	activityRepo repository.ActivityRepository,
+	checkInRepo repository.CheckInRepository,
+	blobStorage storage.BlobStorage,
 	logger *zap.Logger,
+	cfg CertificateWorkerConfig,
 ) *CertificateWorker {
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 1
+	}
+
 	return &CertificateWorker{
-		queue:        queue,
-		generator:    generator,
-		emailService: emailService,
-		logger:       logger,
+		queue:           queue,
+		generator:       generator,
+		templateLoader:  templateLoader,
+		emailService:    emailService,
+		certificateRepo: certificateRepo,
+		jobRepo:         jobRepo,
+		eventRepo:       eventRepo,
+		activityRepo:    activityRepo,
+		checkInRepo:     checkInRepo,
+		blobStorage:     blobStorage,
+		logger:          logger,
+		cfg:             cfg,
+		inFlight:        atomic.Int64{},
 	}
 }
 
+// InFlight retorna quantos jobs estão sendo processados no momento
+func (w *CertificateWorker) InFlight() int64 {
+	return w.inFlight.Load()
+}
+
+// Start consome a fila até ctx ser cancelado
+// Ao cancelar, para de buscar novos jobs e espera os jobs em andamento terminarem (drain);
+// os jobs em andamento não são cancelados junto com ctx
 func (w *CertificateWorker) Start(ctx context.Context) error {
-	w.logger.Info("certificate worker started")
+	w.logger.Info("certificate worker started",
+		zap.Int("concurrency", w.cfg.Concurrency),
+		zap.Duration("poll_timeout", w.cfg.PollTimeout),
+	)
 
-	maxConcurrency := 5
-	sem := make(chan struct{}, maxConcurrency)
+	// jobs que a fila move para a dead-letter sem Nack (reserva expirada) também ficam como failed
+	w.queue.OnDeadLetter(w.markDeadLettered)
+
+	jobCtx := context.WithoutCancel(ctx)
+	sem := make(chan struct{}, w.cfg.Concurrency)
 	var wg sync.WaitGroup
 
 	for {
 		select {
 		case <-ctx.Done():
-			w.logger.Info("certificate worker stopping")
+			w.logger.Info("certificate worker draining", zap.Int64("in_flight", w.InFlight()))
 			wg.Wait() // wait for all jobs to be processed
+			w.logger.Info("certificate worker stopped")
 			return nil
-		default:
-			job, err := w.queue.DequeueWithTimeout(ctx, 10*time.Second)
+		case sem <- struct{}{}: // reserva um slot antes de buscar o job
+			job, err := w.queue.DequeueWithTimeout(ctx, w.cfg.PollTimeout)
 			if err != nil {
+				<-sem
 				if ctx.Err() != nil {
-					wg.Wait()
-					return nil
+					continue
 				}
 				w.logger.Error("failed to dequeue job", zap.Error(err))
 				continue
 			}
 
 			if job == nil {
+				<-sem
 				continue
 			}
 
-			// goroutiners with channels
-			sem <- struct{}{} // add 1 to the semaphore
 			wg.Add(1)
+			w.inFlight.Add(1)
 			go func() {
 				defer func() {
+					w.inFlight.Add(-1)
 					<-sem
 					wg.Done()
 				}()
-				w.processJob(ctx, job)
+				w.handleJob(jobCtx, job)
 			}()
 		}
 	}
 }
 
-func (w *CertificateWorker) processJob(ctx context.Context, job *queue.CertificateJob) {
+// handleJob processa o job e confirma (Ack) ou devolve (Nack) para a fila
+// Se a reserva for perdida durante o processamento, o job já voltou para a fila
+// e não é confirmado nem devolvido por este worker
+func (w *CertificateWorker) handleJob(ctx context.Context, job *queue.CertificateJob) {
+	processCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	stopLease := w.keepLease(ctx, cancel, job)
+	err := w.processJob(processCtx, job)
+	stopLease()
+
+	if errors.Is(context.Cause(processCtx), queue.ErrLeaseLost) {
+		w.logger.Warn("certificate job lease lost, leaving it to the queue",
+			zap.String("job_id", job.GetJobID()),
+			zap.NamedError("process_error", err),
+		)
+		return
+	}
+
+	if err != nil {
+		deadLettered, nackErr := w.queue.Nack(ctx, job, err)
+		if errors.Is(nackErr, queue.ErrLeaseLost) {
+			w.logger.Warn("certificate job lease lost before nack",
+				zap.String("job_id", job.GetJobID()),
+				zap.Error(err),
+			)
+			return
+		}
+
+		// failed só quando o job foi para a dead-letter; nos demais casos a fila ainda vai tentar de novo
+		// (inclusive se o Nack falhar, o job volta para a fila quando a reserva expirar)
+		status := repository.CertificateJobStatusRetrying
+		if deadLettered {
+			status = repository.CertificateJobStatusFailed
+		}
+		w.setStatus(ctx, job, status, err)
+
+		if nackErr != nil {
+			w.logger.Error("failed to nack certificate job",
+				zap.String("job_id", job.GetJobID()),
+				zap.Error(nackErr),
+			)
+			return
+		}
+
+		w.logger.Warn("certificate job failed",
+			zap.String("job_id", job.GetJobID()),
+			zap.Int("attempts", job.GetAttempts()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	if err := w.queue.Ack(ctx, job); err != nil {
+		w.logger.Error("failed to ack certificate job",
+			zap.String("job_id", job.GetJobID()),
+			zap.Error(err),
+		)
+	}
+}
+
+// keepLease renova a reserva do job a cada LeaseExtendInterval até stop ser chamado
+// Se a fila informar que a reserva foi perdida, cancela o processamento com ErrLeaseLost
+func (w *CertificateWorker) keepLease(ctx context.Context, cancel context.CancelCauseFunc, job *queue.CertificateJob) (stop func()) {
+	if w.cfg.LeaseExtendInterval <= 0 {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(w.cfg.LeaseExtendInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := w.queue.ExtendLease(ctx, job)
+				if errors.Is(err, queue.ErrLeaseLost) {
+					cancel(err)
+					return
+				}
+				if err != nil {
+					w.logger.Warn("failed to extend certificate job lease",
+						zap.String("job_id", job.GetJobID()),
+						zap.Error(err),
+					)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
+
+func (w *CertificateWorker) processJob(ctx context.Context, job *queue.CertificateJob) error {
 	w.logger.Info("processing certificate job",
 		zap.String("job_id", job.GetJobID()),
+		zap.String("kind", string(job.GetKind())),
 		zap.String("event", fmt.Sprintf("%s (%s)", job.GetEventInfo().EventName, job.GetEventInfo().EventID)),
 		zap.String("user", fmt.Sprintf("%s <%s>", job.GetUserInfo().UserName, job.GetUserInfo().UserEmail)),
 		zap.String("activity", fmt.Sprintf("%s (%s)", job.GetActivityInfo().ActivityName, job.GetActivityInfo().ActivityID)),
 		zap.Time("checked_at", job.GetCheckedAt()),
 		zap.Time("enqueued_at", job.GetEnqueuedAt()),
+		zap.Int("attempts", job.GetAttempts()),
 	)
 
+	// Descarta o job se o certificado ou o check-in foi revogado depois do enfileiramento
+	revocation, err := w.checkRevocation(ctx, job)
+	if err != nil {
+		return err
+	}
+	if revocation != "" {
+		w.setStatus(ctx, job, repository.CertificateJobStatusRevoked, errors.New(revocation))
+		w.logger.Warn("certificate job discarded",
+			zap.String("job_id", job.GetJobID()),
+			zap.String("reason", revocation),
+		)
+		return nil
+	}
+
+	w.setStatus(ctx, job, repository.CertificateJobStatusGenerating, nil)
+
 	// Calcula a carga horária
-	workload := w.calculateWorkload(job.GetActivityInfo())
+	workload := w.calculateJobWorkload(job)
 
-	// Monta os dados do certificado
-	data := pdf.CertificateData{
-		RecipientName:   job.GetUserInfo().UserName,
-		EventName:       job.GetEventInfo().EventName,
-		EventDate:       w.formatDate(job.GetActivityInfo().ActivityDate),
-		Workload:        workload,
-		DirectorName:    "Dr. João Silva",
-		CoordinatorName: "Dra. Maria Santos",
-		CertificateDate: w.formatDate(time.Now()),
+	// Registra o certificado emitido (idempotente por job, para manter o código em retries)
+	certificate, err := w.issueCertificate(ctx, job, workload)
+	if err != nil {
+		return err
 	}
 
-	// Gera o PDF
-	pdfBytes, err := w.generator.Generate(ctx, data)
+	// Gera o PDF ou reaproveita o já armazenado em uma tentativa anterior
+	pdfBytes, err := w.certificatePDF(ctx, job, certificate, workload)
 	if err != nil {
-		w.logger.Error("failed to generate certificate PDF",
-			zap.String("job_id", job.GetJobID()),
-			zap.Error(err),
-		)
-		return
+		return err
 	}
 
-	w.logger.Info("certificate generated",
-		zap.String("job_id", job.GetJobID()),
-		zap.Int("size_bytes", len(pdfBytes)),
-	)
-
 	// Envia email com certificado
 	emailParams := mail.SendEmailParams{
 		To:      job.GetUserInfo().UserEmail,
@@ -128,61 +299,251 @@ func (w *CertificateWorker) processJob(ctx context.Context, job *queue.Certifica
 		},
 	}
 
+	w.setStatus(ctx, job, repository.CertificateJobStatusSending, nil)
+
 	if err := w.emailService.Send(ctx, emailParams); err != nil {
-		w.logger.Error("failed to send certificate email",
-			zap.String("job_id", job.GetJobID()),
-			zap.String("email", job.GetUserInfo().UserEmail),
-			zap.Error(err),
-		)
-		return
+		return fmt.Errorf("failed to send certificate email to %s: %w", job.GetUserInfo().UserEmail, err)
 	}
 
+	w.setStatus(ctx, job, repository.CertificateJobStatusSent, nil)
+
 	w.logger.Info("certificate email sent successfully",
 		zap.String("job_id", job.GetJobID()),
 		zap.String("email", job.GetUserInfo().UserEmail),
 	)
+
+	return nil
 }
 
-// calculateWorkload calcula a carga horária a partir do horário de início e fim
-func (w *CertificateWorker) calculateWorkload(activity queue.ActivityInfo) string {
-	duration := activity.EndTime.Sub(activity.StartTime)
-	hours := int(duration.Hours())
-	minutes := int(duration.Minutes()) % 60
+// checkRevocation retorna o motivo para descartar o job (vazio se ele continua valendo): certificado revogado, check-in da atividade revogado
+// ou, no consolidado, frequência mínima não mais atingida. No consolidado que continua valendo,
+// as atividades com check-in revogado são retiradas do job
+func (w *CertificateWorker) checkRevocation(ctx context.Context, job *queue.CertificateJob) (string, error) {
+	certificate, err := w.certificateRepo.FindByJobID(ctx, job.GetJobID())
+	if err != nil {
+		return "", fmt.Errorf("failed to find certificate: %w", err)
+	}
+	if certificate != nil && !certificate.IsValid() {
+		return "certificate revoked", nil
+	}
+
+	userID := job.GetUserInfo().UserID
+
+	if job.GetKind() != queue.CertificateJobKindEvent {
+		checkIn, err := w.checkInRepo.FindByUserAndActivity(ctx, userID, job.GetActivityInfo().ActivityID)
+		if err != nil {
+			return "", fmt.Errorf("failed to find check-in: %w", err)
+		}
+		if checkIn == nil {
+			return "check-in revoked", nil
+		}
+		return "", nil
+	}
+
+	event, err := w.eventRepo.FindByID(ctx, job.GetEventInfo().EventID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find event: %w", err)
+	}
+	if event == nil {
+		return "", errors.New("event not found")
+	}
+
+	activities, err := w.activityRepo.FindByEventID(ctx, event.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find activities: %w", err)
+	}
+
+	// check-ins revogados não são retornados
+	checkIns, err := w.checkInRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find check-ins: %w", err)
+	}
+
+	if !event.QualifiesForCertificate(activities, checkIns) {
+		return "check-in revoked, attendance rule no longer met", nil
+	}
+
+	checkedIn := make(map[string]struct{}, len(checkIns))
+	for _, checkIn := range checkIns {
+		checkedIn[checkIn.ActivityID] = struct{}{}
+	}
 
-	if minutes == 0 {
-		if hours == 1 {
-			return "1 hora"
+	attended := make([]queue.ActivityInfo, 0, len(job.Activities))
+	for _, activity := range job.Activities {
+		if _, ok := checkedIn[activity.ActivityID]; ok {
+			attended = append(attended, activity)
 		}
-		return fmt.Sprintf("%d horas", hours)
 	}
+	job.Activities = attended
+
+	return "", nil
+}
+
+// markDeadLettered registra como failed o job que a fila moveu para a dead-letter por conta própria
+func (w *CertificateWorker) markDeadLettered(ctx context.Context, job *queue.CertificateJob, cause error) {
+	w.setStatus(context.WithoutCancel(ctx), job, repository.CertificateJobStatusFailed, cause)
+
+	w.logger.Warn("certificate job moved to dead letter",
+		zap.String("job_id", job.GetJobID()),
+		zap.Int("attempts", job.GetAttempts()),
+		zap.Error(cause),
+	)
+}
+
+// setStatus registra a etapa do job para o acompanhamento dos admins;
+// uma falha aqui não interrompe o processamento do certificado
+func (w *CertificateWorker) setStatus(ctx context.Context, job *queue.CertificateJob, status repository.CertificateJobStatus, cause error) {
+	var lastError *string
+	if cause != nil {
+		msg := cause.Error()
+		lastError = &msg
+	}
+
+	if err := w.jobRepo.UpdateStatus(ctx, job.GetJobID(), status, lastError); err != nil {
+		w.logger.Error("failed to update certificate job status",
+			zap.String("job_id", job.GetJobID()),
+			zap.String("status", string(status)),
+			zap.Error(err),
+		)
+	}
+}
+
+// issueCertificate cria o registro do certificado ou retorna o já emitido para o job
+func (w *CertificateWorker) issueCertificate(ctx context.Context, job *queue.CertificateJob, workload time.Duration) (*entity.Certificate, error) {
+	// o certificado consolidado não pertence a uma atividade
+	var activityID, activityName *string
+	if job.GetKind() == queue.CertificateJobKindActivity {
+		activity := job.GetActivityInfo()
+		activityID = &activity.ActivityID
+		activityName = &activity.ActivityName
+	}
+
+	certificate, err := entity.NewCertificate(entity.NewCertificateParams{
+		JobID:         job.GetJobID(),
+		UserID:        job.GetUserInfo().UserID,
+		EventID:       job.GetEventInfo().EventID,
+		ActivityID:    activityID,
+		RecipientName: job.GetUserInfo().UserName,
+		EventName:     job.GetEventInfo().EventName,
+		ActivityName:  activityName,
+		Workload:      workload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := w.certificateRepo.Save(ctx, certificate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save certificate: %w", err)
+	}
+
+	return saved, nil
+}
 
-	if hours == 0 {
-		if minutes == 1 {
-			return "1 minuto"
+// certificatePDF retorna o PDF armazenado do certificado ou gera e armazena um novo
+func (w *CertificateWorker) certificatePDF(ctx context.Context, job *queue.CertificateJob, certificate *entity.Certificate, workload time.Duration) ([]byte, error) {
+	if certificate.HasFile() {
+		pdfBytes, err := w.blobStorage.Get(ctx, *certificate.StorageKey)
+		if err == nil {
+			return pdfBytes, nil
+		}
+		if !errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, fmt.Errorf("failed to load stored certificate PDF: %w", err)
 		}
-		return fmt.Sprintf("%d minutos", minutes)
+		w.logger.Warn("stored certificate PDF not found, regenerating",
+			zap.String("certificate_id", certificate.ID),
+			zap.String("storage_key", *certificate.StorageKey),
+		)
 	}
 
-	hoursLabel := "hora"
-	if hours > 1 {
-		hoursLabel = "horas"
+	// Carrega o template do evento (ou o padrão)
+	template, err := w.templateLoader.Load(ctx, job.GetEventInfo().EventID)
+	if err != nil {
+		return nil, err
 	}
 
-	minutesLabel := "minuto"
-	if minutes > 1 {
-		minutesLabel = "minutos"
+	// Monta os dados do certificado
+	data := w.buildCertificateData(job, certificate, workload, template)
+
+	// Gera o PDF
+	pdfBytes, err := w.generator.Generate(ctx, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate certificate PDF: %w", err)
 	}
 
-	return fmt.Sprintf("%d %s e %d %s", hours, hoursLabel, minutes, minutesLabel)
+	w.logger.Info("certificate generated",
+		zap.String("job_id", job.GetJobID()),
+		zap.Int("size_bytes", len(pdfBytes)),
+	)
+
+	// Armazena o PDF para que o participante possa baixá-lo depois
+	storageKey := certificate.FileKey()
+	if err := w.blobStorage.Put(ctx, storageKey, pdfBytes, "application/pdf"); err != nil {
+		return nil, fmt.Errorf("failed to store certificate PDF: %w", err)
+	}
+
+	if err := w.certificateRepo.SetStorageKey(ctx, certificate.ID, storageKey); err != nil {
+		return nil, fmt.Errorf("failed to save certificate storage key: %w", err)
+	}
+
+	return pdfBytes, nil
+}
+
+// buildCertificateData monta os dados do PDF; o certificado consolidado lista todas as atividades
+func (w *CertificateWorker) buildCertificateData(job *queue.CertificateJob, certificate *entity.Certificate, workload time.Duration, template pdf.CertificateTemplate) pdf.CertificateData {
+	data := pdf.CertificateData{
+		RecipientName:    job.GetUserInfo().UserName,
+		EventName:        job.GetEventInfo().EventName,
+		ActivityName:     job.GetActivityInfo().ActivityName,
+		EventDate:        pdf.FormatDate(job.GetActivityInfo().ActivityDate),
+		Workload:         entity.FormatWorkload(workload),
+		CertificateDate:  pdf.FormatDate(certificate.IssuedAt),
+		VerificationCode: certificate.VerificationCode,
+		VerificationURL:  pdf.VerificationURL(w.cfg.VerifyBaseURL, certificate.VerificationCode),
+		Activities:       nil,
+		Template:         template,
+	}
+
+	if job.GetKind() != queue.CertificateJobKindEvent {
+		return data
+	}
+
+	names := make([]string, len(job.GetActivities()))
+	data.Activities = make([]pdf.CertificateActivity, len(job.GetActivities()))
+	for i, activity := range job.GetActivities() {
+		names[i] = activity.ActivityName
+		data.Activities[i] = pdf.CertificateActivity{
+			Name:     activity.ActivityName,
+			Date:     pdf.FormatDate(activity.ActivityDate),
+			Workload: entity.FormatWorkload(w.calculateWorkload(activity)),
+		}
+	}
+
+	data.ActivityName = strings.Join(names, ", ")
+	data.EventDate = pdf.FormatDateRange(job.GetEventInfo().StartDate, job.GetEventInfo().EndDate)
+
+	return data
+}
+
+// calculateJobWorkload soma a carga horária das atividades do job
+func (w *CertificateWorker) calculateJobWorkload(job *queue.CertificateJob) time.Duration {
+	if job.GetKind() != queue.CertificateJobKindEvent {
+		return w.calculateWorkload(job.GetActivityInfo())
+	}
+
+	var total time.Duration
+	for _, activity := range job.GetActivities() {
+		total += w.calculateWorkload(activity)
+	}
+	return total
 }
 
-// formatDate formata uma data no padrão brasileiro
-func (w *CertificateWorker) formatDate(t time.Time) string {
-	months := []string{
-		"", "janeiro", "fevereiro", "março", "abril", "maio", "junho",
-		"julho", "agosto", "setembro", "outubro", "novembro", "dezembro",
+// calculateWorkload calcula a carga horária a partir do tempo assistido ou do horário de início e fim
+func (w *CertificateWorker) calculateWorkload(activity queue.ActivityInfo) time.Duration {
+	if activity.WorkloadMinutes != nil {
+		return time.Duration(*activity.WorkloadMinutes) * time.Minute
 	}
-	return fmt.Sprintf("%d de %s de %d", t.Day(), months[t.Month()], t.Year())
+	return activity.EndTime.Sub(activity.StartTime)
 }
 
 // buildCertificateEmailBody constrói o corpo HTML do email de certificado
@@ -0,0 +1,92 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	processwaitlist "github.com/gabrielmatsan/checkin-gate/internal/events/application/usecase/process_waitlist"
+	"github.com/gabrielmatsan/checkin-gate/internal/events/domain/repository"
+	"go.uber.org/zap"
+)
+
+const defaultWaitlistSweepInterval = time.Minute
+
+// WaitlistSweeper varre periodicamente as atividades com lista de espera,
+// expirando as vagas não confirmadas no prazo e oferecendo-as aos próximos da fila
+type WaitlistSweeper struct {
+	registrationRepo repository.RegistrationRepository
+	processWaitlist  *processwaitlist.UseCase
+	logger           *zap.Logger
+	interval         time.Duration
+}
+
+func NewWaitlistSweeper(
+	registrationRepo repository.RegistrationRepository,
+	processWaitlist *processwaitlist.UseCase,
+	logger *zap.Logger,
+	interval time.Duration,
+) *WaitlistSweeper {
+	if interval <= 0 {
+		interval = defaultWaitlistSweepInterval
+	}
+
+	return &WaitlistSweeper{
+		registrationRepo: registrationRepo,
+		processWaitlist:  processWaitlist,
+		logger:           logger,
+		interval:         interval,
+	}
+}
+
+// Start executa a varredura a cada intervalo até ctx ser cancelado
+func (s *WaitlistSweeper) Start(ctx context.Context) {
+	s.logger.Info("waitlist sweeper started", zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("waitlist sweeper stopped")
+			return
+		case <-ticker.C:
+			s.sweep(ctx)
+		}
+	}
+}
+
+func (s *WaitlistSweeper) sweep(ctx context.Context) {
+	activityIDs, err := s.registrationRepo.FindActivityIDsWithWaitlist(ctx, time.Now())
+	if err != nil {
+		if ctx.Err() == nil {
+			s.logger.Error("failed to find activities with waitlist", zap.Error(err))
+		}
+		return
+	}
+
+	for _, activityID := range activityIDs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		output, err := s.processWaitlist.Execute(ctx, &processwaitlist.Input{ActivityID: activityID})
+		if err != nil {
+			s.logger.Error("failed to process waitlist",
+				zap.String("activity_id", activityID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if output.Expired > 0 || len(output.Promoted) > 0 {
+			s.logger.Info("waitlist processed",
+				zap.String("activity_id", activityID),
+				zap.Int64("expired", output.Expired),
+				zap.Int("promoted", len(output.Promoted)),
+				zap.Int("notified", output.NotifiedAttendees),
+				zap.Int("failed_notifications", output.FailedNotifications),
+			)
+		}
+	}
+}
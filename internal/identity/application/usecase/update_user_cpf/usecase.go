@@ -0,0 +1,67 @@
+package updateusercpf
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/identity/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/identity/domain/repository"
+)
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrCPFInUse     = errors.New("cpf already in use")
+)
+
+type Input struct {
+	UserID string
+	CPF    string
+}
+
+type Output struct {
+	User *entity.User
+}
+
+type UseCase struct {
+	userRepo repository.UserRepository
+}
+
+func NewUseCase(userRepo repository.UserRepository) *UseCase {
+	return &UseCase{
+		userRepo: userRepo,
+	}
+}
+
+func (uc *UseCase) Execute(ctx context.Context, input *Input) (*Output, error) {
+	// 1. Find user
+	user, err := uc.userRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	// 2. Validate and normalize CPF
+	if err := user.SetCPF(input.CPF); err != nil {
+		return nil, err
+	}
+
+	// 3. Ensure CPF is not registered to another user
+	owner, err := uc.userRepo.FindByCPF(ctx, *user.CPF)
+	if err != nil {
+		return nil, err
+	}
+	if owner != nil && owner.ID != user.ID {
+		return nil, ErrCPFInUse
+	}
+
+	// 4. Persist
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &Output{
+		User: user,
+	}, nil
+}
@@ -1,6 +1,13 @@
 package entity
 
-import "time"
+import (
+	"errors"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+)
+
+var ErrInvalidCPF = errors.New("invalid cpf")
 
 type UserRole string
 
@@ -22,6 +29,7 @@ type User struct {
 	LastName  string     `db:"last_name"`
 	Email     string     `db:"email"`
 	Role      UserRole   `db:"role"`
+	CPF       *string    `db:"cpf"`
 	CreatedAt time.Time  `db:"created_at"`
 	UpdatedAt *time.Time `db:"updated_at"`
 }
@@ -33,6 +41,7 @@ func NewUser(params NewUserParams) *User {
 		LastName:  params.LastName,
 		Email:     params.Email,
 		Role:      UserRoleUser,
+		CPF:       nil,
 		CreatedAt: time.Now(),
 		UpdatedAt: nil,
 	}
@@ -47,6 +56,17 @@ func (u *User) PromoteToAdmin() {
 	u.touch()
 }
 
+// SetCPF valida e guarda o CPF do usuário, sem pontuação
+func (u *User) SetCPF(cpf string) error {
+	result := lib.ValidateCPF(cpf)
+	if !result.Valid {
+		return ErrInvalidCPF
+	}
+	u.CPF = result.CleanedCPF
+	u.touch()
+	return nil
+}
+
 func (u *User) touch() {
 	now := time.Now()
 	u.UpdatedAt = &now
@@ -11,6 +11,7 @@ type UserRepository interface {
 	FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error)
 	FindByID(ctx context.Context, id string) (*entity.User, error)
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
+	FindByCPF(ctx context.Context, cpf string) (*entity.User, error)
 	Update(ctx context.Context, user *entity.User) error
 	Delete(ctx context.Context, id string) error
 }
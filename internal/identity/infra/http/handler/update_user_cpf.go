@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	updateusercpf "github.com/gabrielmatsan/checkin-gate/internal/identity/application/usecase/update_user_cpf"
+	"github.com/gabrielmatsan/checkin-gate/internal/identity/domain/entity"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
+)
+
+// Request DTOs
+type UpdateUserCPFRequest struct {
+	CPF string `json:"cpf" validate:"required"`
+}
+
+// Response DTOs
+type UpdateUserCPFResponse struct {
+	ID  string `json:"id"`
+	CPF string `json:"cpf"`
+}
+
+// Handler
+type UpdateUserCPFHandler struct {
+	useCase *updateusercpf.UseCase
+}
+
+func NewUpdateUserCPFHandler(uc *updateusercpf.UseCase) *UpdateUserCPFHandler {
+	return &UpdateUserCPFHandler{useCase: uc}
+}
+
+// Handle sets the CPF of the authenticated user.
+// @Summary      Update my CPF
+// @Description  Sets the CPF of the authenticated user, used by admins to find attendees for manual check-ins
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        request  body      UpdateUserCPFRequest  true  "CPF"
+// @Success      200      {object}  UpdateUserCPFResponse
+// @Failure      400      {object}  lib.ErrorResponse
+// @Failure      401      {object}  lib.ErrorResponse
+// @Failure      404      {object}  lib.ErrorResponse
+// @Failure      409      {object}  lib.ErrorResponse
+// @Router       /users/me/cpf [put]
+func (h *UpdateUserCPFHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		lib.RespondError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req UpdateUserCPFRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := lib.Validate(&req); err != nil {
+		lib.RespondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	output, err := h.useCase.Execute(r.Context(), updateUserCPFRequestToInput(&req, userID))
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrInvalidCPF):
+			lib.RespondError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, updateusercpf.ErrUserNotFound):
+			lib.RespondError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, updateusercpf.ErrCPFInUse):
+			lib.RespondError(w, http.StatusConflict, err.Error())
+		default:
+			lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	resp, err := updateUserCPFOutputToResponse(output)
+	if err != nil {
+		lib.RespondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	lib.RespondJSON(w, http.StatusOK, resp)
+}
+
+// Mappers (internal to this handler)
+func updateUserCPFRequestToInput(req *UpdateUserCPFRequest, userID string) *updateusercpf.Input {
+	return &updateusercpf.Input{
+		UserID: userID,
+		CPF:    req.CPF,
+	}
+}
+
+func updateUserCPFOutputToResponse(output *updateusercpf.Output) (*UpdateUserCPFResponse, error) {
+	// o CPF nunca volta completo na resposta
+	masked, err := lib.MaskCPF(*output.User.CPF)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateUserCPFResponse{
+		ID:  output.User.ID,
+		CPF: *masked,
+	}, nil
+}
@@ -5,10 +5,12 @@ import (
 	authenticatewithgoogle "github.com/gabrielmatsan/checkin-gate/internal/identity/application/usecase/authenticate_with_google"
 	getgoogleauthurl "github.com/gabrielmatsan/checkin-gate/internal/identity/application/usecase/get_google_auth_url"
 	refreshtoken "github.com/gabrielmatsan/checkin-gate/internal/identity/application/usecase/refresh_token"
+	updateusercpf "github.com/gabrielmatsan/checkin-gate/internal/identity/application/usecase/update_user_cpf"
 	"github.com/gabrielmatsan/checkin-gate/internal/identity/infra/http/handler"
 	"github.com/gabrielmatsan/checkin-gate/internal/identity/infra/persistence"
 	"github.com/gabrielmatsan/checkin-gate/internal/identity/infra/service"
 	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/middleware"
 	"github.com/go-chi/chi/v5"
 	"github.com/jmoiron/sqlx"
 )
@@ -22,11 +24,13 @@ func RegisterIdentityRoutes(r chi.Router, db *sqlx.DB, cfg *config.Config) {
 	getGoogleAuthURL := getgoogleauthurl.NewUseCase(googleProvider)
 	authenticateWithGoogle := authenticatewithgoogle.NewUseCase(googleProvider, jwtService, userRepo, sessionRepo)
 	refreshToken := refreshtoken.NewUseCase(jwtService, userRepo, sessionRepo)
+	updateUserCPF := updateusercpf.NewUseCase(userRepo)
 
 	// Create individual handlers
 	googleCallbackHandler := handler.NewGoogleCallbackHandler(authenticateWithGoogle)
 	refreshTokenHandler := handler.NewRefreshTokenHandler(refreshToken)
 	getGoogleAuthURLHandler := handler.NewGetGoogleAuthURLHandler(getGoogleAuthURL)
+	updateUserCPFHandler := handler.NewUpdateUserCPFHandler(updateUserCPF)
 
 	r.Route("/auth", func(r chi.Router) {
 		r.Get("/google/url", getGoogleAuthURLHandler.Handle)
@@ -39,6 +43,14 @@ func RegisterIdentityRoutes(r chi.Router, db *sqlx.DB, cfg *config.Config) {
 		// 	r.Use(middleware.Auth(middleware.NewValidateTokenFunc(jwtService.ExtractClaims)))
 		// })
 	})
+
+	r.Route("/users", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(middleware.Auth(middleware.NewValidateTokenFunc(jwtService.ExtractClaims)))
+
+			r.Put("/me/cpf", updateUserCPFHandler.Handle)
+		})
+	})
 }
 
 // GetJWTService returns a new JWT service for use by other modules
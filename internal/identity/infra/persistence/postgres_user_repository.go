@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/gabrielmatsan/checkin-gate/internal/identity/domain/entity"
@@ -13,6 +14,9 @@ import (
 
 var psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
+// userColumns são as colunas selecionadas/retornadas para um entity.User
+var userColumns = []string{"id", "first_name", "last_name", "email", "role", "cpf", "created_at", "updated_at"}
+
 type PostgresUserRepository struct {
 	db shared.DBTX
 }
@@ -29,9 +33,9 @@ func (r *PostgresUserRepository) WithTx(tx *sqlx.Tx) *PostgresUserRepository {
 func (r *PostgresUserRepository) Save(ctx context.Context, user *entity.User) (*entity.User, error) {
 	query, args, err := psql.
 		Insert("users").
-		Columns("id", "first_name", "last_name", "email", "role").
-		Values(user.ID, user.FirstName, user.LastName, user.Email, user.Role).
-		Suffix("RETURNING id, first_name, last_name, email, role, created_at, updated_at").
+		Columns("id", "first_name", "last_name", "email", "role", "cpf").
+		Values(user.ID, user.FirstName, user.LastName, user.Email, user.Role, user.CPF).
+		Suffix("RETURNING " + strings.Join(userColumns, ", ")).
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -47,7 +51,7 @@ func (r *PostgresUserRepository) Save(ctx context.Context, user *entity.User) (*
 
 func (r *PostgresUserRepository) FindByID(ctx context.Context, id string) (*entity.User, error) {
 	query, args, err := psql.
-		Select("id", "first_name", "last_name", "email", "role", "created_at", "updated_at").
+		Select(userColumns...).
 		From("users").
 		Where(sq.Eq{"id": id}).
 		ToSql()
@@ -68,7 +72,7 @@ func (r *PostgresUserRepository) FindByID(ctx context.Context, id string) (*enti
 
 func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	query, args, err := psql.
-		Select("id", "first_name", "last_name", "email", "role", "created_at", "updated_at").
+		Select(userColumns...).
 		From("users").
 		Where(sq.Eq{"email": email}).
 		ToSql()
@@ -87,6 +91,27 @@ func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email string)
 	return &row, nil
 }
 
+func (r *PostgresUserRepository) FindByCPF(ctx context.Context, cpf string) (*entity.User, error) {
+	query, args, err := psql.
+		Select(userColumns...).
+		From("users").
+		Where(sq.Eq{"cpf": cpf}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var row entity.User
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &row, nil
+}
+
 func (r *PostgresUserRepository) Update(ctx context.Context, user *entity.User) error {
 	query, args, err := psql.
 		Update("users").
@@ -94,6 +119,7 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user *entity.User)
 		Set("last_name", user.LastName).
 		Set("email", user.Email).
 		Set("role", user.Role).
+		Set("cpf", user.CPF).
 		Set("updated_at", sq.Expr("NOW()")).
 		Where(sq.Eq{"id": user.ID}).
 		ToSql()
@@ -120,7 +146,7 @@ func (r *PostgresUserRepository) Delete(ctx context.Context, id string) error {
 
 func (r *PostgresUserRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
 	query, args, err := psql.
-		Select("id", "first_name", "last_name", "email", "role", "created_at", "updated_at").
+		Select(userColumns...).
 		From("users").
 		Where(sq.Eq{"id": ids}).
 		ToSql()
@@ -1,9 +1,11 @@
 package shared
 
 import (
+	"errors"
 	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
@@ -43,3 +45,12 @@ func (d *Database) Close() error {
 func (d *Database) HealthCheck() error {
 	return d.Ping()
 }
+
+// uniqueViolationCode é o código do Postgres para violação de constraint única
+const uniqueViolationCode = "23505"
+
+// IsUniqueViolation indica se o erro veio de uma constraint/índice único
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
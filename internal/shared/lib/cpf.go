@@ -40,8 +40,15 @@ func ValidateCPF(cpf string) CPFValidationResult {
 		}
 	}
 
+	if !regexCPF.MatchString(cpf) {
+		return CPFValidationResult{
+			Valid:      false,
+			CleanedCPF: nil,
+		}
+	}
+
 	// Verifica se o CPF é composto por números repetidos
-	if regexCPF.MatchString(cpf) {
+	if isAllSameDigit(cpf) {
 		return CPFValidationResult{
 			Valid:      false,
 			CleanedCPF: &cpf,
@@ -2,8 +2,10 @@ package lib
 
 import (
 	"encoding/json"
+	"fmt"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -30,6 +32,20 @@ func RespondError(w http.ResponseWriter, status int, message string) {
 	RespondJSON(w, status, ErrorResponse{Error: message, Message: ""})
 }
 
+// RespondFile envia um arquivo binário; com filename, o navegador faz o download (attachment)
+func RespondFile(w http.ResponseWriter, contentType string, filename string, content []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	if filename != "" {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	}
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(content); err != nil {
+		http.Error(w, "failed to write response", http.StatusInternalServerError)
+		return
+	}
+}
+
 func GetClientIP(r *http.Request) string {
 	// X-Forwarded-For (proxy/load balancer)
 	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
@@ -0,0 +1,30 @@
+package lib
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/qr"
+)
+
+// GenerateQRCodePNG gera a imagem PNG (size x size pixels) de um QR code com o conteúdo informado
+func GenerateQRCodePNG(content string, size int) ([]byte, error) {
+	code, err := qr.Encode(content, qr.M, qr.Auto)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode qr code: %w", err)
+	}
+
+	code, err = barcode.Scale(code, size, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scale qr code: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, code); err != nil {
+		return nil, fmt.Errorf("failed to encode qr code png: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,36 @@
+package lib
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// alfabeto sem caracteres ambíguos (0/O, 1/I/L)
+const verificationCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+// GenerateVerificationCode gera um código aleatório curto, fácil de digitar
+func GenerateVerificationCode(length int) (string, error) {
+	max := big.NewInt(int64(len(verificationCodeAlphabet)))
+
+	var sb strings.Builder
+	sb.Grow(length)
+
+	for range length {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate verification code: %w", err)
+		}
+		sb.WriteByte(verificationCodeAlphabet[n.Int64()])
+	}
+
+	return sb.String(), nil
+}
+
+// NormalizeVerificationCode remove espaços e hífens e converte para maiúsculas
+func NormalizeVerificationCode(code string) string {
+	code = strings.ReplaceAll(code, "-", "")
+	code = strings.ReplaceAll(code, " ", "")
+	return strings.ToUpper(code)
+}
@@ -0,0 +1,26 @@
+package mail
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// NoopService descarta os emails; usado quando nenhum provedor de email está configurado
+type NoopService struct {
+	logger *zap.Logger
+}
+
+func NewNoopService(logger *zap.Logger) *NoopService {
+	return &NoopService{
+		logger: logger,
+	}
+}
+
+func (s *NoopService) Send(_ context.Context, params SendEmailParams) error {
+	s.logger.Warn("email not sent: no email provider configured",
+		zap.String("to", params.To),
+		zap.String("subject", params.Subject),
+	)
+	return nil
+}
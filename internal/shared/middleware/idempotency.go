@@ -0,0 +1,238 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/shared/lib"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotencyReplayedHeader = "Idempotent-Replayed"
+
+	idempotencyKeyPrefix    = "idempotency:"
+	idempotencyMaxKeyLength = 255
+	// reserva usada quando lockTTL não é informado
+	defaultIdempotencyLockTTL = 1 * time.Minute
+)
+
+// idempotencyRecord é a resposta guardada no Redis para replay
+type idempotencyRecord struct {
+	Completed   bool        `json:"completed"`
+	Fingerprint string      `json:"fingerprint"`
+	Status      int         `json:"status"`
+	Header      http.Header `json:"header"`
+	Body        []byte      `json:"body"`
+}
+
+// Idempotency guarda a resposta de requisições POST com o header Idempotency-Key
+// e a devolve quando o cliente repete a mesma chave (por exemplo, dois toques seguidos no check-in)
+// deve ser usado depois do Auth, a chave é separada por usuário
+// Sem client (Redis não configurado) o header é ignorado e as requisições seguem direto
+//
+// lockTTL é por quanto tempo a chave fica reservada enquanto a requisição é processada;
+// a reserva é renovada até o handler terminar, então só expira se o processo morrer
+func Idempotency(client *redis.Client, ttl, lockTTL time.Duration) func(http.Handler) http.Handler {
+	if lockTTL <= 0 {
+		lockTTL = defaultIdempotencyLockTTL
+	}
+
+	return func(next http.Handler) http.Handler {
+		if client == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(key) > idempotencyMaxKeyLength {
+				lib.RespondError(w, http.StatusBadRequest, "idempotency key too long")
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				lib.RespondError(w, http.StatusBadRequest, "failed to read request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			ctx := r.Context()
+			redisKey := idempotencyKeyPrefix + GetUserID(ctx) + ":" + key
+			fingerprint := requestFingerprint(r, body)
+
+			// reserva a chave; se já existe, é uma repetição
+			pending, err := json.Marshal(idempotencyRecord{
+				Completed:   false,
+				Fingerprint: fingerprint,
+				Status:      0,
+				Header:      nil,
+				Body:        nil,
+			})
+			if err != nil {
+				lib.RespondError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			acquired, err := client.SetNX(ctx, redisKey, pending, lockTTL).Result()
+			if err != nil {
+				lib.RespondError(w, http.StatusInternalServerError, "failed to check idempotency key")
+				return
+			}
+
+			if !acquired {
+				replayIdempotentResponse(w, r, client, redisKey, fingerprint)
+				return
+			}
+
+			// a gravação final não pode ser perdida se o cliente desconectar
+			storeCtx := context.WithoutCancel(ctx)
+
+			stopRenewal := renewIdempotencyLock(ctx, client, redisKey, lockTTL)
+			completed := false
+			defer func() {
+				stopRenewal()
+				// panic no handler: libera a chave para o cliente poder tentar de novo
+				if !completed {
+					client.Del(storeCtx, redisKey)
+				}
+			}()
+
+			recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK, body: bytes.Buffer{}}
+			next.ServeHTTP(recorder, r)
+			completed = true
+			stopRenewal()
+
+			// erros de servidor não são guardados, o cliente pode tentar de novo com a mesma chave
+			if recorder.status >= http.StatusInternalServerError {
+				client.Del(storeCtx, redisKey)
+				return
+			}
+
+			record, err := json.Marshal(idempotencyRecord{
+				Completed:   true,
+				Fingerprint: fingerprint,
+				Status:      recorder.status,
+				Header:      recorder.Header().Clone(),
+				Body:        recorder.body.Bytes(),
+			})
+			if err != nil {
+				client.Del(storeCtx, redisKey)
+				return
+			}
+
+			if err := client.Set(storeCtx, redisKey, record, ttl).Err(); err != nil {
+				client.Del(storeCtx, redisKey)
+			}
+		})
+	}
+}
+
+func replayIdempotentResponse(w http.ResponseWriter, r *http.Request, client *redis.Client, redisKey, fingerprint string) {
+	raw, err := client.Get(r.Context(), redisKey).Bytes()
+	if err != nil {
+		// a chave expirou entre o SETNX e o GET
+		if errors.Is(err, redis.Nil) {
+			lib.RespondError(w, http.StatusConflict, "request with this idempotency key is still being processed")
+			return
+		}
+		lib.RespondError(w, http.StatusInternalServerError, "failed to check idempotency key")
+		return
+	}
+
+	var record idempotencyRecord
+	if err := json.Unmarshal(raw, &record); err != nil {
+		lib.RespondError(w, http.StatusInternalServerError, "failed to decode idempotent response")
+		return
+	}
+
+	if record.Fingerprint != fingerprint {
+		lib.RespondError(w, http.StatusUnprocessableEntity, "idempotency key already used for a different request")
+		return
+	}
+
+	if !record.Completed {
+		lib.RespondError(w, http.StatusConflict, "request with this idempotency key is still being processed")
+		return
+	}
+
+	for name, values := range record.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(IdempotencyReplayedHeader, "true")
+	w.WriteHeader(record.Status)
+	if _, err := w.Write(record.Body); err != nil {
+		http.Error(w, "failed to write response", http.StatusInternalServerError)
+	}
+}
+
+// renewIdempotencyLock estende a reserva da chave a cada metade de lockTTL enquanto o handler roda,
+// para que uma requisição lenta não libere a chave para uma repetição; a função retornada para a renovação e pode ser chamada mais de uma vez
+func renewIdempotencyLock(ctx context.Context, client *redis.Client, redisKey string, lockTTL time.Duration) func() {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(lockTTL / 2)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				client.Expire(ctx, redisKey, lockTTL)
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			cancel()
+			<-done
+		})
+	}
+}
+
+// requestFingerprint identifica a requisição para impedir a mesma chave em requisições diferentes
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte(r.URL.RequestURI()))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder repassa a resposta ao cliente e guarda uma cópia
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	rec.body.Write(b)
+	return rec.ResponseWriter.Write(b)
+}
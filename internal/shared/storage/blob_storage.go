@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gabrielmatsan/checkin-gate/internal/config"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+var ErrObjectNotFound = errors.New("object not found")
+
+// BlobStorage armazena arquivos binários (ex: PDFs de certificados) identificados por uma chave
+type BlobStorage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get retorna ErrObjectNotFound se a chave não existir
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// NewBlobStorageFromConfig cria a implementação definida em STORAGE_DRIVER
+func NewBlobStorageFromConfig(ctx context.Context, cfg config.StorageConfig) (BlobStorage, error) {
+	switch cfg.Driver {
+	case DriverLocal:
+		return NewLocalBlobStorage(cfg.LocalDir)
+	case DriverS3:
+		return NewS3BlobStorage(ctx, S3BlobStorageConfig{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			UseSSL:    cfg.S3UseSSL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", cfg.Driver)
+	}
+}
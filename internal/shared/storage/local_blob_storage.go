@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalBlobStorage salva os arquivos em um diretório do sistema de arquivos
+type LocalBlobStorage struct {
+	baseDir string
+}
+
+func NewLocalBlobStorage(baseDir string) (*LocalBlobStorage, error) {
+	absDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve storage dir: %w", err)
+	}
+
+	if err := os.MkdirAll(absDir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create storage dir: %w", err)
+	}
+
+	return &LocalBlobStorage{baseDir: absDir}, nil
+}
+
+func (s *LocalBlobStorage) Put(_ context.Context, key string, data []byte, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create object dir: %w", err)
+	}
+
+	// escreve em um arquivo temporário e renomeia para não deixar arquivos pela metade
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close object: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to move object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LocalBlobStorage) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return data, nil
+}
+
+func (s *LocalBlobStorage) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+// path resolve a chave dentro do diretório base, impedindo chaves como "../x"
+func (s *LocalBlobStorage) path(key string) (string, error) {
+	path := filepath.Join(s.baseDir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, s.baseDir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid object key: %s", key)
+	}
+
+	return path, nil
+}
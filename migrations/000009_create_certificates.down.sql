@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS update_certificates_updated_at ON certificates;
+
+DROP INDEX IF EXISTS idx_certificates_user_id;
+DROP INDEX IF EXISTS idx_certificates_event_id;
+
+DROP TABLE IF EXISTS certificates;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS certificates (
+  id VARCHAR(36) PRIMARY KEY,
+  job_id VARCHAR(36) NOT NULL UNIQUE,
+  verification_code VARCHAR(16) NOT NULL UNIQUE,
+  user_id VARCHAR(36) NOT NULL REFERENCES users(id),
+  event_id VARCHAR(36) NOT NULL REFERENCES events(id),
+  activity_id VARCHAR(36) REFERENCES activities(id),
+  recipient_name VARCHAR(255) NOT NULL,
+  event_name VARCHAR(255) NOT NULL,
+  activity_name VARCHAR(255),
+  workload_minutes INT NOT NULL,
+  issued_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  revoked_at TIMESTAMPTZ,
+  revoked_reason VARCHAR(500),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_certificates_user_id ON certificates (user_id);
+CREATE INDEX IF NOT EXISTS idx_certificates_event_id ON certificates (event_id);
+
+-- trigger para atualizar a coluna updated_at
+CREATE TRIGGER update_certificates_updated_at
+BEFORE UPDATE ON certificates
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
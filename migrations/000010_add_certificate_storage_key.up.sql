@@ -0,0 +1 @@
+ALTER TABLE certificates ADD COLUMN storage_key VARCHAR(512);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_certificate_templates_updated_at ON certificate_templates;
+
+DROP TABLE IF EXISTS certificate_templates;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS certificate_templates (
+  id VARCHAR(36) PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL UNIQUE REFERENCES events(id) ON DELETE CASCADE,
+  title VARCHAR(100) NOT NULL,
+  body_text VARCHAR(1000) NOT NULL,
+  signatories JSONB NOT NULL DEFAULT '[]',
+  logo_key VARCHAR(512),
+  primary_color VARCHAR(7) NOT NULL,
+  accent_color VARCHAR(7) NOT NULL,
+  secondary_color VARCHAR(7) NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at TIMESTAMPTZ
+);
+
+-- trigger para atualizar a coluna updated_at
+CREATE TRIGGER update_certificate_templates_updated_at
+BEFORE UPDATE ON certificate_templates
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
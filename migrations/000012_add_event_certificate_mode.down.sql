@@ -0,0 +1,2 @@
+ALTER TABLE events DROP column certificate_mode;
+DROP TYPE certificate_mode;
@@ -0,0 +1,3 @@
+CREATE TYPE certificate_mode AS ENUM ('per_activity', 'consolidated', 'both');
+
+ALTER TABLE events ADD column certificate_mode certificate_mode NOT NULL DEFAULT 'per_activity';
@@ -0,0 +1,3 @@
+ALTER TABLE events DROP column attendance_threshold;
+ALTER TABLE events DROP column attendance_rule;
+DROP TYPE attendance_rule;
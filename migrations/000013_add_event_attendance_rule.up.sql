@@ -0,0 +1,4 @@
+CREATE TYPE attendance_rule AS ENUM ('none', 'min_activities', 'min_percentage', 'min_hours');
+
+ALTER TABLE events ADD column attendance_rule attendance_rule NOT NULL DEFAULT 'none';
+ALTER TABLE events ADD column attendance_threshold DOUBLE PRECISION NOT NULL DEFAULT 0;
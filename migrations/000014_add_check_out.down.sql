@@ -0,0 +1,5 @@
+ALTER TABLE events DROP column workload_source;
+DROP TYPE workload_source;
+
+ALTER TABLE check_ins DROP COLUMN attended_minutes;
+ALTER TABLE check_ins DROP COLUMN checked_out_at;
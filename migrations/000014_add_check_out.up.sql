@@ -0,0 +1,6 @@
+ALTER TABLE check_ins ADD COLUMN checked_out_at TIMESTAMPTZ;
+ALTER TABLE check_ins ADD COLUMN attended_minutes INTEGER;
+
+CREATE TYPE workload_source AS ENUM ('scheduled', 'attended');
+
+ALTER TABLE events ADD column workload_source workload_source NOT NULL DEFAULT 'scheduled';
@@ -0,0 +1,2 @@
+ALTER TABLE activities DROP COLUMN checkin_token_period_seconds;
+ALTER TABLE activities DROP COLUMN checkin_token_required;
@@ -0,0 +1,2 @@
+ALTER TABLE activities ADD COLUMN checkin_token_required BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE activities ADD COLUMN checkin_token_period_seconds INTEGER NOT NULL DEFAULT 30;
@@ -0,0 +1,3 @@
+ALTER TABLE activities DROP COLUMN checkin_closes_after_minutes;
+ALTER TABLE activities DROP COLUMN checkin_opens_before_minutes;
+ALTER TABLE activities DROP COLUMN checkin_window_enabled;
@@ -0,0 +1,3 @@
+ALTER TABLE activities ADD COLUMN checkin_window_enabled BOOLEAN NOT NULL DEFAULT true;
+ALTER TABLE activities ADD COLUMN checkin_opens_before_minutes INTEGER NOT NULL DEFAULT 15;
+ALTER TABLE activities ADD COLUMN checkin_closes_after_minutes INTEGER NOT NULL DEFAULT 15;
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS check_in_rejections;
+
+ALTER TABLE activities DROP COLUMN venue_radius_meters;
+ALTER TABLE activities DROP COLUMN venue_longitude;
+ALTER TABLE activities DROP COLUMN venue_latitude;
+ALTER TABLE activities DROP COLUMN venue_name;
+
+ALTER TABLE events DROP COLUMN venue_radius_meters;
+ALTER TABLE events DROP COLUMN venue_longitude;
+ALTER TABLE events DROP COLUMN venue_latitude;
+ALTER TABLE events DROP COLUMN venue_name;
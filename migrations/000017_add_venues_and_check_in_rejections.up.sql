@@ -0,0 +1,22 @@
+ALTER TABLE events ADD COLUMN venue_name VARCHAR(255);
+ALTER TABLE events ADD COLUMN venue_latitude DOUBLE PRECISION;
+ALTER TABLE events ADD COLUMN venue_longitude DOUBLE PRECISION;
+ALTER TABLE events ADD COLUMN venue_radius_meters INTEGER;
+
+ALTER TABLE activities ADD COLUMN venue_name VARCHAR(255);
+ALTER TABLE activities ADD COLUMN venue_latitude DOUBLE PRECISION;
+ALTER TABLE activities ADD COLUMN venue_longitude DOUBLE PRECISION;
+ALTER TABLE activities ADD COLUMN venue_radius_meters INTEGER;
+
+CREATE TABLE IF NOT EXISTS check_in_rejections (
+  id VARCHAR(36) PRIMARY KEY,
+  user_id VARCHAR(36) NOT NULL REFERENCES users(id),
+  activity_id VARCHAR(36) NOT NULL REFERENCES activities(id) ON DELETE CASCADE,
+  reason VARCHAR(32) NOT NULL,
+  latitude DOUBLE PRECISION,
+  longitude DOUBLE PRECISION,
+  distance_meters DOUBLE PRECISION,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_check_in_rejections_activity_id ON check_in_rejections (activity_id);
@@ -0,0 +1,4 @@
+ALTER TABLE check_ins DROP COLUMN registration_reason;
+ALTER TABLE check_ins DROP COLUMN registered_by;
+
+ALTER TABLE users DROP COLUMN cpf;
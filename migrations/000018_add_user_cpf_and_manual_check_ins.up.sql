@@ -0,0 +1,4 @@
+ALTER TABLE users ADD COLUMN cpf VARCHAR(11) UNIQUE;
+
+ALTER TABLE check_ins ADD COLUMN registered_by VARCHAR(36) REFERENCES users(id);
+ALTER TABLE check_ins ADD COLUMN registration_reason VARCHAR(500);
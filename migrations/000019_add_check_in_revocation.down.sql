@@ -0,0 +1,3 @@
+ALTER TABLE check_ins DROP COLUMN revocation_reason;
+ALTER TABLE check_ins DROP COLUMN revoked_by;
+ALTER TABLE check_ins DROP COLUMN revoked_at;
@@ -0,0 +1,3 @@
+ALTER TABLE check_ins ADD COLUMN revoked_at TIMESTAMPTZ;
+ALTER TABLE check_ins ADD COLUMN revoked_by VARCHAR(36) REFERENCES users(id);
+ALTER TABLE check_ins ADD COLUMN revocation_reason VARCHAR(500);
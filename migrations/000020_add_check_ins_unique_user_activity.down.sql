@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_check_ins_user_activity_active;
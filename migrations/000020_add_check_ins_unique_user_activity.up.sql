@@ -0,0 +1,16 @@
+-- revoga check-ins duplicados existentes, mantendo o mais antigo de cada usuário/atividade
+UPDATE check_ins c
+SET revoked_at = NOW(),
+    revocation_reason = 'duplicate check-in'
+FROM (
+    SELECT id,
+           ROW_NUMBER() OVER (PARTITION BY user_id, activity_id ORDER BY checked_at, id) AS position
+    FROM check_ins
+    WHERE revoked_at IS NULL
+) d
+WHERE c.id = d.id AND d.position > 1;
+
+-- check-ins revogados não contam, o participante pode fazer um novo check-in
+CREATE UNIQUE INDEX IF NOT EXISTS idx_check_ins_user_activity_active
+    ON check_ins (user_id, activity_id)
+    WHERE revoked_at IS NULL;
@@ -0,0 +1 @@
+-- não é possível saber quais eventos eram rascunho; nada a desfazer
@@ -0,0 +1,3 @@
+-- antes das transições de status todo evento ficava em rascunho e recebia check-ins;
+-- os eventos existentes passam a publicados para continuarem abertos
+UPDATE events SET status = 'published' WHERE status = 'draft';
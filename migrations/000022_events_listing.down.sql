@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_events_status;
+DROP INDEX IF EXISTS idx_events_created_at_id;
+
+ALTER TABLE events ADD COLUMN allowed_domains_json JSONB;
+
+UPDATE events
+SET allowed_domains_json = to_jsonb(allowed_domains)
+WHERE allowed_domains IS NOT NULL;
+
+ALTER TABLE events DROP COLUMN allowed_domains;
+ALTER TABLE events RENAME COLUMN allowed_domains_json TO allowed_domains;
@@ -0,0 +1,14 @@
+-- allowed_domains era JSONB, mas a aplicação grava e lê um array de texto;
+-- TEXT[] também permite filtrar por domínio com ANY na listagem de eventos
+ALTER TABLE events ADD COLUMN allowed_domains_list TEXT[];
+
+UPDATE events
+SET allowed_domains_list = ARRAY(SELECT jsonb_array_elements_text(allowed_domains))
+WHERE jsonb_typeof(allowed_domains) = 'array';
+
+ALTER TABLE events DROP COLUMN allowed_domains;
+ALTER TABLE events RENAME COLUMN allowed_domains_list TO allowed_domains;
+
+-- paginação por cursor (created_at, id) e filtros da listagem
+CREATE INDEX IF NOT EXISTS idx_events_created_at_id ON events (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_events_status ON events (status);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_certificates_user_event;
+DROP INDEX IF EXISTS idx_check_ins_user_checked_at_id;
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_check_ins_user_checked_at_id ON check_ins (user_id, checked_at DESC, id DESC) WHERE revoked_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_certificates_user_event ON certificates (user_id, event_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_activities_event_track;
+
+ALTER TABLE activities DROP COLUMN IF EXISTS track;
@@ -0,0 +1,3 @@
+ALTER TABLE activities ADD COLUMN track VARCHAR(100);
+
+CREATE INDEX IF NOT EXISTS idx_activities_event_track ON activities (event_id, track) WHERE track IS NOT NULL;
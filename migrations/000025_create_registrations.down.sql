@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS registrations;
+
+ALTER TABLE activities DROP COLUMN IF EXISTS capacity;
+ALTER TABLE events DROP COLUMN IF EXISTS registration_required;
@@ -0,0 +1,22 @@
+ALTER TABLE events ADD COLUMN registration_required BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE activities ADD COLUMN capacity INTEGER CHECK (capacity > 0);
+
+-- activity_id nulo é a inscrição no evento; preenchido, a inscrição em uma atividade do evento
+CREATE TABLE IF NOT EXISTS registrations (
+  id VARCHAR(36) PRIMARY KEY,
+  user_id VARCHAR(36) NOT NULL REFERENCES users(id),
+  event_id VARCHAR(36) NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  activity_id VARCHAR(36) REFERENCES activities(id) ON DELETE CASCADE,
+  status VARCHAR(20) NOT NULL DEFAULT 'confirmed',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  cancelled_at TIMESTAMPTZ
+);
+
+-- inscrições canceladas não contam, o participante pode se inscrever de novo
+CREATE UNIQUE INDEX IF NOT EXISTS idx_registrations_user_event_active
+    ON registrations (user_id, event_id)
+    WHERE activity_id IS NULL AND status <> 'cancelled';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_registrations_user_activity_active
+    ON registrations (user_id, activity_id)
+    WHERE activity_id IS NOT NULL AND status <> 'cancelled';
+CREATE INDEX IF NOT EXISTS idx_registrations_activity_status ON registrations (activity_id, status);
@@ -0,0 +1,21 @@
+DROP INDEX IF EXISTS idx_registrations_pending_deadline;
+DROP INDEX IF EXISTS idx_registrations_waitlist;
+
+-- quem estava na lista de espera ou aguardando confirmação perde a inscrição na atividade
+UPDATE registrations
+SET status = 'cancelled', cancelled_at = NOW()
+WHERE status IN ('waitlisted', 'pending_confirmation');
+
+UPDATE registrations SET status = 'cancelled' WHERE status = 'expired';
+
+DROP INDEX IF EXISTS idx_registrations_user_event_active;
+DROP INDEX IF EXISTS idx_registrations_user_activity_active;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_registrations_user_event_active
+    ON registrations (user_id, event_id)
+    WHERE activity_id IS NULL AND status <> 'cancelled';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_registrations_user_activity_active
+    ON registrations (user_id, activity_id)
+    WHERE activity_id IS NOT NULL AND status <> 'cancelled';
+
+ALTER TABLE registrations DROP COLUMN IF EXISTS confirmation_deadline;
@@ -0,0 +1,20 @@
+-- vaga oferecida a quem estava na lista de espera; expira se não for confirmada até o prazo
+ALTER TABLE registrations ADD COLUMN confirmation_deadline TIMESTAMPTZ;
+
+-- inscrições expiradas também liberam o participante para se inscrever de novo
+DROP INDEX IF EXISTS idx_registrations_user_event_active;
+DROP INDEX IF EXISTS idx_registrations_user_activity_active;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_registrations_user_event_active
+    ON registrations (user_id, event_id)
+    WHERE activity_id IS NULL AND status NOT IN ('cancelled', 'expired');
+CREATE UNIQUE INDEX IF NOT EXISTS idx_registrations_user_activity_active
+    ON registrations (user_id, activity_id)
+    WHERE activity_id IS NOT NULL AND status NOT IN ('cancelled', 'expired');
+
+CREATE INDEX IF NOT EXISTS idx_registrations_waitlist
+    ON registrations (activity_id, created_at)
+    WHERE status = 'waitlisted';
+CREATE INDEX IF NOT EXISTS idx_registrations_pending_deadline
+    ON registrations (confirmation_deadline)
+    WHERE status = 'pending_confirmation';
@@ -0,0 +1,13 @@
+-- transactional outbox: os jobs de certificado são gravados na mesma transação que finaliza o evento
+-- e publicados na fila pelo relay do worker; dispatched_at nulo indica job ainda não publicado
+CREATE TABLE IF NOT EXISTS certificate_job_outbox (
+  job_id VARCHAR(36) PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+  payload JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  dispatched_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_certificate_job_outbox_pending
+    ON certificate_job_outbox (created_at)
+    WHERE dispatched_at IS NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_certificate_job_outbox_dedup_key;
+ALTER TABLE certificate_job_outbox DROP COLUMN IF EXISTS dedup_key;
@@ -0,0 +1,20 @@
+-- um certificado por participante e atividade (ou por participante no evento, no consolidado);
+-- finalizar o evento de novo só grava os jobs que ainda não existem
+ALTER TABLE certificate_job_outbox ADD COLUMN dedup_key VARCHAR(120);
+
+UPDATE certificate_job_outbox
+SET dedup_key = CASE
+    WHEN payload->>'kind' = 'event'
+        THEN 'event:' || (payload->'user_info'->>'user_id') || ':' || event_id
+    ELSE 'activity:' || (payload->'user_info'->>'user_id') || ':' || (payload->'activity_info'->>'activity_id')
+END;
+
+-- finalizações repetidas antes desta migration: mantém o job mais antigo de cada chave
+DELETE FROM certificate_job_outbox o
+USING certificate_job_outbox older
+WHERE o.dedup_key = older.dedup_key
+  AND (older.created_at, older.job_id) < (o.created_at, o.job_id);
+
+ALTER TABLE certificate_job_outbox ALTER COLUMN dedup_key SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_certificate_job_outbox_dedup_key ON certificate_job_outbox (dedup_key);
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_certificate_jobs_event_status;
+
+ALTER TABLE certificate_jobs
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS updated_at;
+
+ALTER INDEX idx_certificate_jobs_dedup_key RENAME TO idx_certificate_job_outbox_dedup_key;
+ALTER INDEX idx_certificate_jobs_pending RENAME TO idx_certificate_job_outbox_pending;
+ALTER TABLE certificate_jobs RENAME CONSTRAINT certificate_jobs_event_id_fkey TO certificate_job_outbox_event_id_fkey;
+ALTER TABLE certificate_jobs RENAME CONSTRAINT certificate_jobs_pkey TO certificate_job_outbox_pkey;
+ALTER TABLE certificate_jobs RENAME TO certificate_job_outbox;
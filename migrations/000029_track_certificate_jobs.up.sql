@@ -0,0 +1,21 @@
+-- o outbox passa a registrar o ciclo de vida de cada job de certificado
+ALTER TABLE certificate_job_outbox RENAME TO certificate_jobs;
+ALTER TABLE certificate_jobs RENAME CONSTRAINT certificate_job_outbox_pkey TO certificate_jobs_pkey;
+ALTER TABLE certificate_jobs RENAME CONSTRAINT certificate_job_outbox_event_id_fkey TO certificate_jobs_event_id_fkey;
+ALTER INDEX idx_certificate_job_outbox_pending RENAME TO idx_certificate_jobs_pending;
+ALTER INDEX idx_certificate_job_outbox_dedup_key RENAME TO idx_certificate_jobs_dedup_key;
+
+-- status: queued, generating, sending, sent ou failed (com last_error); attempts conta os processamentos
+ALTER TABLE certificate_jobs
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'queued',
+    ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN last_error TEXT,
+    ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- jobs processados antes desta migration: o PDF armazenado indica que o certificado foi gerado e enviado
+UPDATE certificate_jobs j
+SET status = 'sent'
+FROM certificates c
+WHERE c.job_id = j.job_id AND c.storage_key IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_certificate_jobs_event_status ON certificate_jobs (event_id, status);
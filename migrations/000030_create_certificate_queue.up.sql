@@ -0,0 +1,20 @@
+-- fila de certificados no Postgres (CERTIFICATE_QUEUE_DRIVER=postgres)
+CREATE TABLE IF NOT EXISTS certificate_queue (
+  job_id VARCHAR(36) PRIMARY KEY,
+  payload JSONB NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  -- a partir de quando o job pode ser reservado: retry com backoff ou fim da reserva de um worker
+  visible_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  -- preenchido enquanto o job está reservado; identifica a reserva no Ack e no Nack
+  lease_id VARCHAR(36),
+  enqueued_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  -- preenchido quando o job esgota as tentativas (dead-letter)
+  dead_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_certificate_queue_visible
+    ON certificate_queue (visible_at)
+    WHERE dead_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_certificate_queue_dead
+    ON certificate_queue (dead_at DESC)
+    WHERE dead_at IS NOT NULL;